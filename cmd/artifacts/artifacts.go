@@ -0,0 +1,299 @@
+// Package artifacts 점검 실행 한 번에서 만들어지는 보고서와 증적 파일의 저장 위치 및 목록(index.json)
+//
+// 실행마다 출력 디렉터리 아래에 이름 템플릿으로 만든 디렉터리를 하나 두고 모든 산출물을 모음
+//
+//	<출력 디렉터리>/<이름>/
+//	  index.json                      산출물 목록
+//	  results.json                    전체 체크 결과
+//	  eks-checklist-report-*.html     보고서 (HTML/PDF, TUI 내보내기)
+//	  evidence/<체크 ID>/...          체크별 증적
+package artifacts
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+const (
+	// DefaultOutputDir 기본 출력 디렉터리
+	DefaultOutputDir = "output"
+	// DefaultNameTemplate 기본 실행 디렉터리 이름 템플릿
+	DefaultNameTemplate = "{cluster}-{timestamp}"
+	// TimestampFormat {timestamp} 형식
+	TimestampFormat = "20060102-150405"
+	// EvidenceDirName 체크별 증적을 모아 두는 하위 디렉터리 이름
+	EvidenceDirName = "evidence"
+	// IndexFileName 산출물 목록 파일 이름
+	IndexFileName = "index.json"
+	// ResultsFileName 전체 체크 결과 파일 이름
+	ResultsFileName = "results.json"
+)
+
+// Placeholders 이름 템플릿에서 사용할 수 있는 항목
+var Placeholders = []string{"cluster", "account", "region", "timestamp"}
+
+var (
+	placeholderPattern = regexp.MustCompile(`\{([^{}]*)\}`)
+	// unsafeNamePattern 템플릿 값에서 바꾸는 문자 (경로 구분자 포함, 앞뒤의 . - 는 제거)
+	unsafeNamePattern = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+)
+
+// NameVars 이름 템플릿에 채울 값 (비어 있는 값은 unknown으로 채움)
+type NameVars struct {
+	Cluster string
+	Account string
+	Region  string
+	Time    time.Time
+}
+
+// ClusterVars 클러스터 이름과 ARN으로 이름 템플릿 값 생성
+// 계정과 리전은 ARN에서 가져오며, ARN이 없으면 region 인자를 리전으로 사용
+func ClusterVars(cluster, clusterARN, region string, now time.Time) NameVars {
+	vars := NameVars{Cluster: cluster, Region: region, Time: now}
+	if parsed, err := arn.Parse(clusterARN); err == nil {
+		vars.Account = parsed.AccountID
+		vars.Region = parsed.Region
+	}
+	return vars
+}
+
+func (v NameVars) values() map[string]string {
+	values := map[string]string{
+		"cluster":   v.Cluster,
+		"account":   v.Account,
+		"region":    v.Region,
+		"timestamp": v.Time.Format(TimestampFormat),
+	}
+	for key, value := range values {
+		value = strings.Trim(unsafeNamePattern.ReplaceAllString(value, "-"), "-.")
+		if value == "" {
+			value = "unknown"
+		}
+		values[key] = value
+	}
+	return values
+}
+
+// RenderName 이름 템플릿의 {cluster}, {account}, {region}, {timestamp}를 값으로 바꿔 실행 디렉터리 이름 생성
+// 템플릿에 '/'를 넣으면 하위 디렉터리로 나눌 수 있음 (예: {account}/{region}/{cluster}-{timestamp})
+func RenderName(template string, vars NameVars) (string, error) {
+	if strings.TrimSpace(template) == "" {
+		return "", fmt.Errorf("출력 이름 템플릿이 비어 있습니다")
+	}
+
+	values := vars.values()
+	var unknown []string
+	name := placeholderPattern.ReplaceAllStringFunc(template, func(m string) string {
+		key := m[1 : len(m)-1]
+		value, ok := values[key]
+		if !ok {
+			unknown = append(unknown, m)
+		}
+		return value
+	})
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("알 수 없는 출력 이름 템플릿 항목: %s (사용 가능: {%s})",
+			strings.Join(unknown, ", "), strings.Join(Placeholders, "}, {"))
+	}
+
+	name = filepath.ToSlash(name)
+	if path.IsAbs(name) || filepath.IsAbs(name) {
+		return "", fmt.Errorf("출력 이름은 출력 디렉터리 기준 상대 경로여야 합니다: %s", name)
+	}
+	for _, part := range strings.Split(name, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("유효하지 않은 출력 이름: %s", name)
+		}
+	}
+	return name, nil
+}
+
+// ValidateNameTemplate 점검 실행 전에 이름 템플릿 오류 확인
+func ValidateNameTemplate(template string) error {
+	_, err := RenderName(template, NameVars{Time: time.Now()})
+	return err
+}
+
+// Layout 실행 한 번의 출력 디렉터리
+// 디렉터리는 파일을 저장할 때 만들어지므로 Layout 생성만으로는 파일 시스템을 변경하지 않음
+type Layout struct {
+	// Dir 실행 디렉터리 (<출력 디렉터리>/<이름>)
+	Dir  string
+	Vars NameVars
+}
+
+// New 출력 디렉터리와 이름 템플릿으로 실행 디렉터리 결정
+func New(outputDir, nameTemplate string, vars NameVars) (*Layout, error) {
+	if outputDir == "" {
+		outputDir = DefaultOutputDir
+	}
+	name, err := RenderName(nameTemplate, vars)
+	if err != nil {
+		return nil, err
+	}
+	return &Layout{Dir: filepath.Join(outputDir, filepath.FromSlash(name)), Vars: vars}, nil
+}
+
+// EvidenceDir 체크별 증적 디렉터리의 상위 디렉터리
+func (l *Layout) EvidenceDir() string {
+	return filepath.Join(l.Dir, EvidenceDirName)
+}
+
+// Result 체크 결과 (results.json 형식이며 산출물 목록에도 체크 정보로 사용)
+type Result struct {
+	ID        string   `json:"id"`
+	Category  string   `json:"category,omitempty"`
+	CheckName string   `json:"checkName"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message,omitempty"`
+	Resources []string `json:"resources,omitempty"`
+	Runbook   string   `json:"runbook,omitempty"`
+}
+
+// File 산출물 파일 (경로는 실행 디렉터리 기준, '/' 구분)
+type File struct {
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+}
+
+// CheckArtifacts 체크 하나가 만든 증적 파일
+type CheckArtifacts struct {
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+	Files  []File `json:"files"`
+}
+
+// Index 실행 디렉터리의 산출물 목록 (index.json)
+type Index struct {
+	Cluster   string           `json:"cluster"`
+	Account   string           `json:"account,omitempty"`
+	Region    string           `json:"region,omitempty"`
+	StartedAt time.Time        `json:"startedAt"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Reports   []File           `json:"reports"`
+	Checks    []CheckArtifacts `json:"checks"`
+}
+
+// BuildIndex 실행 디렉터리의 파일을 모아 산출물 목록 생성
+// evidence/<체크 ID> 아래 파일은 해당 체크의 증적, 그 외 파일은 보고서로 분류
+// results에 있는 체크는 증적이 없어도 실행 순서대로 포함하고, 증적만 있는 체크는 ID 순으로 뒤에 추가
+func (l *Layout) BuildIndex(results []Result, now time.Time) (Index, error) {
+	index := Index{
+		Cluster:   l.Vars.Cluster,
+		Account:   l.Vars.Account,
+		Region:    l.Vars.Region,
+		StartedAt: l.Vars.Time,
+		CreatedAt: now,
+		Reports:   []File{},
+		Checks:    []CheckArtifacts{},
+	}
+
+	evidence := map[string][]File{}
+	err := filepath.WalkDir(l.Dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) && p == l.Dir {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(l.Dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == IndexFileName {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		file := File{Path: rel, Size: info.Size()}
+
+		parts := strings.SplitN(rel, "/", 3)
+		if len(parts) == 3 && parts[0] == EvidenceDirName {
+			evidence[parts[1]] = append(evidence[parts[1]], file)
+		} else {
+			index.Reports = append(index.Reports, file)
+		}
+		return nil
+	})
+	if err != nil {
+		return index, err
+	}
+
+	seen := map[string]bool{}
+	for _, r := range results {
+		seen[r.ID] = true
+		files := evidence[r.ID]
+		if files == nil {
+			files = []File{}
+		}
+		index.Checks = append(index.Checks, CheckArtifacts{ID: r.ID, Name: r.CheckName, Status: r.Status, Files: files})
+	}
+	var extra []string
+	for id := range evidence {
+		if !seen[id] {
+			extra = append(extra, id)
+		}
+	}
+	sort.Strings(extra)
+	for _, id := range extra {
+		index.Checks = append(index.Checks, CheckArtifacts{ID: id, Files: evidence[id]})
+	}
+	return index, nil
+}
+
+// WriteIndex 산출물 목록을 실행 디렉터리의 index.json으로 저장하고 경로 반환
+func (l *Layout) WriteIndex(results []Result, now time.Time) (string, error) {
+	index, err := l.BuildIndex(results, now)
+	if err != nil {
+		return "", fmt.Errorf("산출물 목록 생성 실패: %w", err)
+	}
+	path, err := l.writeJSON(IndexFileName, index)
+	if err != nil {
+		return "", fmt.Errorf("산출물 목록 저장 실패: %w", err)
+	}
+	return path, nil
+}
+
+// WriteResults 전체 체크 결과를 실행 디렉터리의 results.json으로 저장하고 경로 반환
+func (l *Layout) WriteResults(results []Result) (string, error) {
+	if results == nil {
+		results = []Result{}
+	}
+	path, err := l.writeJSON(ResultsFileName, results)
+	if err != nil {
+		return "", fmt.Errorf("체크 결과 저장 실패: %w", err)
+	}
+	return path, nil
+}
+
+func (l *Layout) writeJSON(name string, v interface{}) (string, error) {
+	if err := os.MkdirAll(l.Dir, os.ModePerm); err != nil {
+		return "", err
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(l.Dir, name)
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,198 @@
+package artifacts_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"eks-checklist/cmd/artifacts"
+	"eks-checklist/cmd/testutils"
+)
+
+var testTime = time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func TestRenderName(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "artifacts_name.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			vars := artifacts.NameVars{
+				Cluster: tc["cluster"].(string),
+				Account: tc["account"].(string),
+				Region:  tc["region"].(string),
+				Time:    testTime,
+			}
+			name, err := artifacts.RenderName(tc["template"].(string), vars)
+			if tc["expect_error"].(bool) {
+				if err == nil {
+					t.Fatalf("Test '%s' failed: expected error, got name %q", testName, name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test '%s' failed: unexpected error: %v", testName, err)
+			}
+			if name != tc["expect_name"].(string) {
+				t.Errorf("Test '%s' failed: expected %q, got %q", testName, tc["expect_name"], name)
+			}
+		})
+	}
+}
+
+func TestClusterVars(t *testing.T) {
+	vars := artifacts.ClusterVars("prod", "arn:aws:eks:us-west-2:123456789012:cluster/prod", "ap-northeast-2", testTime)
+	if vars.Account != "123456789012" || vars.Region != "us-west-2" {
+		t.Errorf("expected account/region from ARN, got %+v", vars)
+	}
+
+	vars = artifacts.ClusterVars("prod", "", "ap-northeast-2", testTime)
+	if vars.Account != "" || vars.Region != "ap-northeast-2" {
+		t.Errorf("expected fallback region without ARN, got %+v", vars)
+	}
+}
+
+func TestWriteIndex(t *testing.T) {
+	vars := artifacts.NameVars{Cluster: "prod", Account: "123456789012", Region: "us-west-2", Time: testTime}
+	layout, err := artifacts.New(t.TempDir(), artifacts.DefaultNameTemplate, vars)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 실행 디렉터리가 아직 없어도 빈 목록 생성
+	index, err := layout.BuildIndex(nil, testTime)
+	if err != nil {
+		t.Fatalf("BuildIndex on missing directory failed: %v", err)
+	}
+	if len(index.Reports) != 0 || len(index.Checks) != 0 {
+		t.Errorf("expected empty index, got %+v", index)
+	}
+
+	files := map[string]string{
+		"eks-checklist-report-20250102-030405.html": "<html></html>",
+		"evidence/SEC-002/access-entries.json":      "[]",
+		"evidence/REL-007/yamls/default-app.yaml":   "kind: Pod",
+		"evidence/REL-007/resource_allocation.json": "{}",
+		"evidence/NET-004/ingresses.json":           "[]",
+	}
+	for name, content := range files {
+		path := filepath.Join(layout.Dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results := []artifacts.Result{
+		{ID: "SEC-002", CheckName: "[SEC-002] 접근 제어", Status: "manual"},
+		{ID: "SEC-001", CheckName: "[SEC-001] 엔드포인트", Status: "pass"},
+		{ID: "REL-007", CheckName: "[REL-007] 리소스 할당", Status: "fail"},
+	}
+	path, err := layout.WriteIndex(results, testTime)
+	if err != nil {
+		t.Fatalf("WriteIndex failed: %v", err)
+	}
+	if path != filepath.Join(layout.Dir, artifacts.IndexFileName) {
+		t.Errorf("unexpected index path %s", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written artifacts.Index
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("index.json is not valid JSON: %v", err)
+	}
+
+	if written.Cluster != "prod" || written.Account != "123456789012" || written.Region != "us-west-2" {
+		t.Errorf("unexpected run info: %+v", written)
+	}
+	if len(written.Reports) != 1 || written.Reports[0].Path != "eks-checklist-report-20250102-030405.html" || written.Reports[0].Size != 13 {
+		t.Errorf("unexpected reports: %+v", written.Reports)
+	}
+
+	// 실행 순서대로 나열하고, 증적만 있는 체크(NET-004)는 뒤에 추가
+	expectFiles := map[string][]string{
+		"SEC-002": {"evidence/SEC-002/access-entries.json"},
+		"SEC-001": {},
+		"REL-007": {"evidence/REL-007/resource_allocation.json", "evidence/REL-007/yamls/default-app.yaml"},
+		"NET-004": {"evidence/NET-004/ingresses.json"},
+	}
+	expectOrder := []string{"SEC-002", "SEC-001", "REL-007", "NET-004"}
+	if len(written.Checks) != len(expectOrder) {
+		t.Fatalf("expected %d checks, got %+v", len(expectOrder), written.Checks)
+	}
+	for i, check := range written.Checks {
+		if check.ID != expectOrder[i] {
+			t.Errorf("check %d: expected %s, got %s", i, expectOrder[i], check.ID)
+		}
+		var paths []string
+		for _, f := range check.Files {
+			paths = append(paths, f.Path)
+		}
+		if len(paths) != len(expectFiles[check.ID]) {
+			t.Errorf("check %s: expected files %v, got %v", check.ID, expectFiles[check.ID], paths)
+			continue
+		}
+		for j := range paths {
+			if paths[j] != expectFiles[check.ID][j] {
+				t.Errorf("check %s: expected files %v, got %v", check.ID, expectFiles[check.ID], paths)
+				break
+			}
+		}
+	}
+	if written.Checks[2].Status != "fail" || written.Checks[3].Status != "" {
+		t.Errorf("unexpected statuses: %+v", written.Checks)
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	layout, err := artifacts.New(t.TempDir(), "{cluster}", artifacts.NameVars{Cluster: "prod", Time: testTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 실행한 체크가 없어도 빈 배열로 저장
+	path, err := layout.WriteResults(nil)
+	if err != nil {
+		t.Fatalf("WriteResults failed: %v", err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "[]\n" {
+		t.Errorf("expected empty array, got %q", data)
+	}
+
+	results := []artifacts.Result{{ID: "SEC-002", Category: "Security Check", CheckName: "[SEC-002] 접근 제어", Status: "manual", Message: "수동 점검", Resources: []string{"aws-auth"}}}
+	if _, err := layout.WriteResults(results); err != nil {
+		t.Fatal(err)
+	}
+	data, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var written []artifacts.Result
+	if err := json.Unmarshal(data, &written); err != nil {
+		t.Fatalf("results.json is not valid JSON: %v", err)
+	}
+	if len(written) != 1 || written[0].ID != "SEC-002" || written[0].Message != "수동 점검" || written[0].Resources[0] != "aws-auth" {
+		t.Errorf("unexpected results: %+v", written)
+	}
+
+	// results.json은 산출물 목록에서 보고서로 분류
+	index, err := layout.BuildIndex(results, testTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index.Reports) != 1 || index.Reports[0].Path != artifacts.ResultsFileName {
+		t.Errorf("expected results.json in reports, got %+v", index.Reports)
+	}
+}
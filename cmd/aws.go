@@ -3,44 +3,56 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"log"
-	"os"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
 	"github.com/aws/aws-sdk-go-v2/config"
 )
 
 var (
-	awsConfig aws.Config
-	once      sync.Once
+	awsConfig    aws.Config
+	awsConfigErr error
+	once         sync.Once
 )
 
+// 스로틀링 등 재시도 가능한 오류 발생 시 최대 시도 횟수 (--aws-max-attempts)
+var awsMaxAttempts = 10
+
 // AWS 설정을 로드하는 함수 (싱글톤)
-func GetAWSConfig(AWS_PROFILE string) aws.Config {
+// 스로틀링 오류가 발생하면 지수 백오프로 재시도하고, 반복되면 요청 속도 자체를 낮추는 adaptive 재시도 모드 사용
+func GetAWSConfig(AWS_PROFILE string) (aws.Config, error) {
 	fmt.Printf("AWS_PROFILE: %s\n", AWS_PROFILE)
 	if AWS_PROFILE != "" {
 		once.Do(func() {
-			var err error
-			awsConfig, err = config.LoadDefaultConfig(
+			awsConfig, awsConfigErr = config.LoadDefaultConfig(
 				context.TODO(),
 				config.WithSharedConfigProfile(AWS_PROFILE),
+				config.WithRetryer(newAWSRetryer),
 			)
-			if err != nil {
-				log.Printf("AWS 프로필 '%s'로 설정을 로드할 수 없습니다: %v", AWS_PROFILE, err)
-				// 실패 시 종료
-				os.Exit(1)
+			if awsConfigErr != nil {
+				awsConfigErr = fmt.Errorf("AWS 프로필 '%s'로 설정을 로드할 수 없습니다: %v", AWS_PROFILE, awsConfigErr)
 			}
 		})
-		return awsConfig
+		return awsConfig, awsConfigErr
 	} else {
 		once.Do(func() {
-			var err error
-			awsConfig, err = config.LoadDefaultConfig(context.TODO())
-			if err != nil {
-				log.Fatalf("unable to load SDK config, %v", err)
+			awsConfig, awsConfigErr = config.LoadDefaultConfig(context.TODO(), config.WithRetryer(newAWSRetryer))
+			if awsConfigErr != nil {
+				awsConfigErr = fmt.Errorf("unable to load SDK config, %v", awsConfigErr)
 			}
 		})
-		return awsConfig
+		return awsConfig, awsConfigErr
 	}
 }
+
+// newAWSRetryer 스로틀링에 대응하는 adaptive 모드 재시도기 생성
+func newAWSRetryer() aws.Retryer {
+	return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+		o.StandardOptions = append(o.StandardOptions, func(so *retry.StandardOptions) {
+			so.MaxAttempts = awsMaxAttempts
+			so.MaxBackoff = 20 * time.Second
+		})
+	})
+}
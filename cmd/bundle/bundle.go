@@ -0,0 +1,379 @@
+// Package bundle 실행 디렉터리의 보고서와 증적 파일을 감사용 증적 번들(tar.gz)로 묶고 검증
+//
+// 번들 구성 (<이름>은 실행 디렉터리 이름)
+//
+//	<이름>/bundle.json        도구 버전, 실행 명령과 플래그, 클러스터 정보
+//	<이름>/...                실행 디렉터리의 모든 파일 (보고서, results.json, index.json, evidence/)
+//	<이름>/SHA256SUMS         위 파일의 SHA-256 목록 (sha256sum -c 형식)
+//	<이름>/SHA256SUMS.sig     SHA256SUMS의 ed25519 서명 (base64, 서명 키를 지정한 경우)
+package bundle
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// MetadataName 번들 정보 파일 이름
+	MetadataName = "bundle.json"
+	// ManifestName SHA-256 목록 파일 이름
+	ManifestName = "SHA256SUMS"
+	// SignatureName 서명 파일 이름
+	SignatureName = "SHA256SUMS.sig"
+)
+
+// Version 도구 버전 (릴리스 빌드에서 -ldflags "-X eks-checklist/cmd/bundle.Version=<태그>"로 지정)
+var Version = ""
+
+// ToolVersion 도구 버전 (Version이 없으면 Go 빌드 정보의 모듈 버전 또는 커밋)
+func ToolVersion() string {
+	if Version != "" {
+		return Version
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	// go install로 설치하면 모듈 버전, 소스에서 빌드하면 (devel)이므로 커밋을 함께 표시
+	version := info.Main.Version
+	if version == "" || version == "(devel)" {
+		for _, setting := range info.Settings {
+			if setting.Key == "vcs.revision" {
+				version += " " + setting.Value
+			}
+		}
+	}
+	return version
+}
+
+// Metadata 번들 정보 (bundle.json)
+type Metadata struct {
+	Tool      string    `json:"tool"`
+	Version   string    `json:"version"`
+	GoVersion string    `json:"goVersion,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Command 실행한 명령 (예: eks-checklist scan-manifests)
+	Command string `json:"command"`
+	// Args 명령 인자 (플래그 제외)
+	Args []string `json:"args,omitempty"`
+	// Flags 명시적으로 지정한 플래그
+	Flags   map[string]string `json:"flags,omitempty"`
+	Cluster string            `json:"cluster,omitempty"`
+	Account string            `json:"account,omitempty"`
+	Region  string            `json:"region,omitempty"`
+}
+
+// NewMetadata 현재 도구 버전으로 번들 정보 생성
+func NewMetadata(now time.Time) Metadata {
+	meta := Metadata{Tool: "eks-checklist", Version: ToolVersion(), CreatedAt: now}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		meta.GoVersion = info.GoVersion
+	}
+	return meta
+}
+
+// entry 번들에 넣을 파일
+type entry struct {
+	name string
+	data []byte
+	mode int64
+	mod  time.Time
+}
+
+// Create 실행 디렉터리 dir의 파일과 번들 정보, SHA-256 목록을 tar.gz로 w에 작성
+// key가 있으면 SHA-256 목록을 ed25519로 서명해 함께 포함
+func Create(w io.Writer, dir string, meta Metadata, key ed25519.PrivateKey) error {
+	var entries []entry
+
+	metaData, err := json.MarshalIndent(meta, "", "  ")
+	if err != nil {
+		return err
+	}
+	entries = append(entries, entry{name: MetadataName, data: append(metaData, '\n'), mode: 0644, mod: meta.CreatedAt})
+
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		switch rel {
+		case MetadataName, ManifestName, SignatureName:
+			return fmt.Errorf("실행 디렉터리에 번들 예약 파일 이름이 있습니다: %s", rel)
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry{name: rel, data: data, mode: int64(info.Mode().Perm()), mod: info.ModTime()})
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("실행 디렉터리 읽기 실패: %w", err)
+	}
+
+	manifest := buildManifest(entries)
+	entries = append(entries, entry{name: ManifestName, data: manifest, mode: 0644, mod: meta.CreatedAt})
+	if key != nil {
+		signature := base64.StdEncoding.EncodeToString(ed25519.Sign(key, manifest)) + "\n"
+		entries = append(entries, entry{name: SignatureName, data: []byte(signature), mode: 0644, mod: meta.CreatedAt})
+	}
+
+	prefix := filepath.Base(filepath.Clean(dir))
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	for _, e := range entries {
+		header := &tar.Header{
+			Name:     prefix + "/" + e.name,
+			Mode:     e.mode,
+			Size:     int64(len(e.data)),
+			ModTime:  e.mod,
+			Typeflag: tar.TypeReg,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(e.data); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// CreateFile 번들을 path에 저장 (번들 파일은 실행 디렉터리 밖에 있어야 함)
+func CreateFile(path, dir string, meta Metadata, key ed25519.PrivateKey) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return err
+	}
+	if rel, err := filepath.Rel(absDir, absPath); err == nil && !strings.HasPrefix(rel, "..") {
+		return fmt.Errorf("번들 파일은 실행 디렉터리(%s) 밖에 저장해야 합니다", dir)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(absPath), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("번들 파일 생성 실패: %w", err)
+	}
+	if err := Create(file, dir, meta, key); err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+	return file.Close()
+}
+
+// buildManifest 파일 이름순 SHA-256 목록 ("<해시>  <경로>" 형식)
+func buildManifest(entries []entry) []byte {
+	lines := make([]string, 0, len(entries))
+	for _, e := range entries {
+		sum := sha256.Sum256(e.data)
+		lines = append(lines, hex.EncodeToString(sum[:])+"  "+e.name)
+	}
+	sort.Slice(lines, func(i, j int) bool { return lines[i][66:] < lines[j][66:] })
+	return []byte(strings.Join(lines, "\n") + "\n")
+}
+
+// parseManifest SHA-256 목록을 경로 -> 해시로 변환
+func parseManifest(data []byte) (map[string]string, error) {
+	sums := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			continue
+		}
+		sum, name, ok := strings.Cut(line, "  ")
+		if !ok || len(sum) != sha256.Size*2 || name == "" {
+			return nil, fmt.Errorf("%s 형식 오류: %q", ManifestName, line)
+		}
+		if _, exists := sums[name]; exists {
+			return nil, fmt.Errorf("%s에 중복된 경로: %s", ManifestName, name)
+		}
+		sums[name] = strings.ToLower(sum)
+	}
+	return sums, scanner.Err()
+}
+
+// VerifyResult 번들 검증 결과
+type VerifyResult struct {
+	// Name 번들 최상위 디렉터리 이름
+	Name     string
+	Metadata Metadata
+	// Files SHA-256 목록의 파일 수
+	Files int
+	// Signed 서명 파일 포함 여부
+	Signed bool
+	// SignatureVerified 공개 키로 서명을 확인했는지 여부
+	SignatureVerified bool
+	// Problems 해시 불일치, 누락/추가된 파일, 서명 오류
+	Problems []string
+}
+
+// Valid 문제가 없는지 여부
+func (r *VerifyResult) Valid() bool {
+	return len(r.Problems) == 0
+}
+
+// Verify 번들의 파일을 SHA-256 목록과 비교하고, 공개 키가 있으면 서명 확인
+// 공개 키를 지정했는데 서명이 없거나 맞지 않으면 문제로 기록
+// 번들 형식 자체를 읽을 수 없으면 오류 반환
+func Verify(r io.Reader, pub ed25519.PublicKey) (*VerifyResult, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("gzip 형식이 아닙니다: %w", err)
+	}
+	defer gz.Close()
+
+	result := &VerifyResult{}
+	sums := map[string]string{}
+	var manifest, signature []byte
+
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tar 읽기 실패: %w", err)
+		}
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+		if header.Typeflag != tar.TypeReg {
+			result.Problems = append(result.Problems, "일반 파일이 아닌 항목: "+header.Name)
+			continue
+		}
+
+		prefix, name, ok := strings.Cut(header.Name, "/")
+		if !ok || name == "" || path.Clean(name) != name || strings.HasPrefix(name, "../") || path.IsAbs(name) {
+			result.Problems = append(result.Problems, "유효하지 않은 경로: "+header.Name)
+			continue
+		}
+		if result.Name == "" {
+			result.Name = prefix
+		} else if prefix != result.Name {
+			result.Problems = append(result.Problems, "다른 디렉터리의 파일: "+header.Name)
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("tar 읽기 실패: %w", err)
+		}
+		switch name {
+		case ManifestName:
+			manifest = data
+			continue
+		case SignatureName:
+			signature = data
+			continue
+		case MetadataName:
+			if err := json.Unmarshal(data, &result.Metadata); err != nil {
+				result.Problems = append(result.Problems, MetadataName+" 형식 오류: "+err.Error())
+			}
+		}
+		sum := sha256.Sum256(data)
+		sums[name] = hex.EncodeToString(sum[:])
+	}
+
+	if manifest == nil {
+		return nil, fmt.Errorf("%s 파일이 없습니다", ManifestName)
+	}
+	expected, err := parseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+	result.Files = len(expected)
+
+	names := make([]string, 0, len(expected))
+	for name := range expected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		actual, ok := sums[name]
+		switch {
+		case !ok:
+			result.Problems = append(result.Problems, "누락된 파일: "+name)
+		case actual != expected[name]:
+			result.Problems = append(result.Problems, "해시 불일치: "+name)
+		}
+	}
+	var extra []string
+	for name := range sums {
+		if _, ok := expected[name]; !ok {
+			extra = append(extra, name)
+		}
+	}
+	sort.Strings(extra)
+	for _, name := range extra {
+		result.Problems = append(result.Problems, "목록에 없는 파일: "+name)
+	}
+	if _, ok := expected[MetadataName]; !ok {
+		result.Problems = append(result.Problems, MetadataName+"이 SHA-256 목록에 없습니다")
+	}
+
+	result.Signed = signature != nil
+	if pub != nil {
+		switch {
+		case !result.Signed:
+			result.Problems = append(result.Problems, "서명 파일("+SignatureName+")이 없습니다")
+		default:
+			sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+			if err != nil || !ed25519.Verify(pub, manifest, sig) {
+				result.Problems = append(result.Problems, "서명이 공개 키와 일치하지 않습니다")
+			} else {
+				result.SignatureVerified = true
+			}
+		}
+	}
+	return result, nil
+}
+
+// VerifyFile 번들 파일 검증
+func VerifyFile(path string, pub ed25519.PublicKey) (*VerifyResult, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return Verify(file, pub)
+}
@@ -0,0 +1,292 @@
+package bundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"eks-checklist/cmd/bundle"
+	"eks-checklist/cmd/testutils"
+)
+
+const accessEntries = "evidence/SEC-002/access-entries.json"
+
+// writeRunDir 테스트용 실행 디렉터리 생성
+func writeRunDir(t *testing.T) string {
+	dir := filepath.Join(t.TempDir(), "run")
+	files := map[string]string{
+		"eks-checklist-report-20250102-030405.html": "<html></html>",
+		"results.json":                         `[{"id":"SEC-002","status":"manual"}]`,
+		"index.json":                           `{"cluster":"prod"}`,
+		accessEntries:                          `[{"principalArn":"arn:aws:iam::123456789012:role/admin"}]`,
+		"evidence/SEC-009/default-policy.yaml": "kind: NetworkPolicy\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+type tarFile struct {
+	name string
+	data []byte
+}
+
+func readTar(t *testing.T, data []byte) []tarFile {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	var files []tarFile
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, tarFile{name: header.Name, data: content})
+	}
+	return files
+}
+
+func writeTar(t *testing.T, files []tarFile) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.data)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// tamper 번들을 변조
+func tamper(t *testing.T, data []byte, mode string) []byte {
+	if mode == "none" {
+		return data
+	}
+	files := readTar(t, data)
+	var result []tarFile
+	for _, f := range files {
+		switch {
+		case f.name == "run/"+accessEntries && mode == "remove":
+			continue
+		case f.name == "run/"+accessEntries && (mode == "modify" || mode == "modify-and-rehash"):
+			f.data = []byte(`[]`)
+		case f.name == "run/"+bundle.ManifestName && mode == "modify-and-rehash":
+			// 변조한 파일에 맞춰 SHA256SUMS도 다시 계산 (서명은 그대로)
+			sum := sha256.Sum256([]byte(`[]`))
+			var lines []string
+			for _, line := range strings.Split(strings.TrimSpace(string(f.data)), "\n") {
+				if strings.HasSuffix(line, "  "+accessEntries) {
+					line = hex.EncodeToString(sum[:]) + "  " + accessEntries
+				}
+				lines = append(lines, line)
+			}
+			f.data = []byte(strings.Join(lines, "\n") + "\n")
+		}
+		result = append(result, f)
+	}
+	switch mode {
+	case "add":
+		result = append(result, tarFile{name: "run/evidence/SEC-002/extra.json", data: []byte("{}")})
+	case "traversal":
+		result = append(result, tarFile{name: "run/../outside.txt", data: []byte("x")})
+	}
+	return writeTar(t, result)
+}
+
+func TestCreateAndVerify(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "bundle_verify.yaml")
+
+	signerPub, signerKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			dir := writeRunDir(t)
+			meta := bundle.NewMetadata(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+			meta.Command = "eks-checklist"
+			meta.Flags = map[string]string{"output": "html", "bundle": "out.tar.gz"}
+			meta.Cluster = "prod"
+
+			var key ed25519.PrivateKey
+			if tc["sign"].(bool) {
+				key = signerKey
+			}
+			var buf bytes.Buffer
+			if err := bundle.Create(&buf, dir, meta, key); err != nil {
+				t.Fatalf("Test '%s' failed: Create error: %v", testName, err)
+			}
+
+			var pub ed25519.PublicKey
+			switch tc["verify_key"].(string) {
+			case "signer":
+				pub = signerPub
+			case "other":
+				pub = otherPub
+			}
+
+			data := tamper(t, buf.Bytes(), tc["tamper"].(string))
+			result, err := bundle.Verify(bytes.NewReader(data), pub)
+			if err != nil {
+				t.Fatalf("Test '%s' failed: Verify error: %v", testName, err)
+			}
+
+			if result.Valid() != tc["expect_valid"].(bool) {
+				t.Errorf("Test '%s' failed: expected valid=%v, got problems %v", testName, tc["expect_valid"], result.Problems)
+			}
+			if result.Signed != tc["expect_signed"].(bool) {
+				t.Errorf("Test '%s' failed: expected signed=%v, got %v", testName, tc["expect_signed"], result.Signed)
+			}
+			if expect := tc["expect_problem"].(string); expect != "" {
+				found := false
+				for _, problem := range result.Problems {
+					if problem == expect {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Test '%s' failed: expected problem %q, got %v", testName, expect, result.Problems)
+				}
+			}
+			if result.Valid() {
+				// bundle.json과 실행 디렉터리의 파일 5개
+				if result.Name != "run" || result.Files != 6 {
+					t.Errorf("Test '%s' failed: unexpected name/files %s/%d", testName, result.Name, result.Files)
+				}
+				if result.Metadata.Cluster != "prod" || result.Metadata.Flags["output"] != "html" {
+					t.Errorf("Test '%s' failed: unexpected metadata %+v", testName, result.Metadata)
+				}
+				if result.SignatureVerified != (pub != nil) {
+					t.Errorf("Test '%s' failed: unexpected SignatureVerified %v", testName, result.SignatureVerified)
+				}
+			}
+		})
+	}
+}
+
+func TestManifestFormat(t *testing.T) {
+	var buf bytes.Buffer
+	if err := bundle.Create(&buf, writeRunDir(t), bundle.NewMetadata(time.Now()), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// sha256sum -c로 확인할 수 있도록 "<해시>  <경로>" 형식으로 경로순 정렬
+	for _, f := range readTar(t, buf.Bytes()) {
+		if f.name != "run/"+bundle.ManifestName {
+			continue
+		}
+		expect := []string{
+			bundle.MetadataName,
+			"eks-checklist-report-20250102-030405.html",
+			accessEntries,
+			"evidence/SEC-009/default-policy.yaml",
+			"index.json",
+			"results.json",
+		}
+		lines := strings.Split(strings.TrimSpace(string(f.data)), "\n")
+		if len(lines) != len(expect) {
+			t.Fatalf("expected %d lines, got:\n%s", len(expect), f.data)
+		}
+		for i, line := range lines {
+			sum, name, ok := strings.Cut(line, "  ")
+			if !ok || len(sum) != 64 || name != expect[i] {
+				t.Errorf("line %d: expected %s, got %q", i, expect[i], line)
+			}
+		}
+		return
+	}
+	t.Fatal("SHA256SUMS not found in bundle")
+}
+
+func TestCreateFileRejectsPathInsideRunDir(t *testing.T) {
+	dir := writeRunDir(t)
+	if err := bundle.CreateFile(filepath.Join(dir, "bundle.tar.gz"), dir, bundle.NewMetadata(time.Now()), nil); err == nil {
+		t.Fatal("expected error for bundle path inside run directory")
+	}
+
+	path := filepath.Join(filepath.Dir(dir), "out", "bundle.tar.gz")
+	if err := bundle.CreateFile(path, dir, bundle.NewMetadata(time.Now()), nil); err != nil {
+		t.Fatalf("CreateFile failed: %v", err)
+	}
+	result, err := bundle.VerifyFile(path, nil)
+	if err != nil || !result.Valid() {
+		t.Fatalf("expected valid bundle, got %v / %+v", err, result)
+	}
+}
+
+func TestParseKeys(t *testing.T) {
+	pub, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubDER, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parsedKey, err := bundle.ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+	if err != nil || !parsedKey.Equal(key) {
+		t.Fatalf("ParsePrivateKey failed: %v", err)
+	}
+	parsedPub, err := bundle.ParsePublicKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER}))
+	if err != nil || !parsedPub.Equal(pub) {
+		t.Fatalf("ParsePublicKey failed: %v", err)
+	}
+
+	// 종류가 다른 PEM 블록은 거부
+	if _, err := bundle.ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})); err == nil {
+		t.Error("expected error for public key passed as private key")
+	}
+	if _, err := bundle.ParsePublicKey([]byte("not a key")); err == nil {
+		t.Error("expected error for invalid public key")
+	}
+}
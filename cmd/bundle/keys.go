@@ -0,0 +1,63 @@
+package bundle
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+)
+
+// ParsePrivateKey PEM(PKCS#8) 형식의 ed25519 개인 키
+// openssl genpkey -algorithm ed25519 -out bundle-key.pem 으로 만든 키를 그대로 사용할 수 있음
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return nil, fmt.Errorf("PEM 형식의 개인 키(PRIVATE KEY)가 아닙니다")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("개인 키 파싱 실패: %w", err)
+	}
+	private, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("ed25519 개인 키가 아닙니다 (%T)", key)
+	}
+	return private, nil
+}
+
+// ParsePublicKey PEM(PKIX) 형식의 ed25519 공개 키
+// openssl pkey -in bundle-key.pem -pubout -out bundle-key.pub 으로 만든 키를 그대로 사용할 수 있음
+func ParsePublicKey(data []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("PEM 형식의 공개 키(PUBLIC KEY)가 아닙니다")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("공개 키 파싱 실패: %w", err)
+	}
+	public, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("ed25519 공개 키가 아닙니다 (%T)", key)
+	}
+	return public, nil
+}
+
+// LoadPrivateKey 파일에서 ed25519 개인 키 읽기
+func LoadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePrivateKey(data)
+}
+
+// LoadPublicKey 파일에서 ed25519 공개 키 읽기
+func LoadPublicKey(path string) (ed25519.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublicKey(data)
+}
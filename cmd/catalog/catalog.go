@@ -0,0 +1,137 @@
+// Package catalog 등록된 체크 목록(카탈로그) 출력과 Runbook 문서 인덱스/mkdocs 내비게이션 생성
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"eks-checklist/cmd/checks"
+)
+
+// Entry 체크 카탈로그 항목
+type Entry struct {
+	ID       string `json:"id"`
+	Category string `json:"category"`
+	Title    string `json:"title"`
+	Mode     string `json:"mode"`
+	// Resources 조회하는 Kubernetes 리소스 (resource.group 형식, 단건 조회 리소스 포함)
+	Resources  []string `json:"resources,omitempty"`
+	AWSActions []string `json:"awsActions,omitempty"`
+	Runbook    string   `json:"runbook"`
+}
+
+// Entries 체크 목록을 카탈로그 항목으로 변환 (순서 유지)
+func Entries(list []checks.Check) []Entry {
+	entries := make([]Entry, 0, len(list))
+	for _, check := range list {
+		resources := append([]string{}, check.Resources...)
+		for _, resource := range check.Gets {
+			if !check.Reads(resource) {
+				resources = append(resources, resource)
+			}
+		}
+		entries = append(entries, Entry{
+			ID:         check.ID,
+			Category:   check.Category,
+			Title:      check.Name,
+			Mode:       check.Mode,
+			Resources:  resources,
+			AWSActions: check.AWSActions,
+			Runbook:    check.Runbook(),
+		})
+	}
+	return entries
+}
+
+// ModeLabel 점검 방식 표시 이름
+func ModeLabel(mode string) string {
+	switch mode {
+	case checks.ModeAutomatic:
+		return "자동"
+	case checks.ModeManual:
+		return "수동"
+	case checks.ModeMixed:
+		return "자동/수동"
+	}
+	return mode
+}
+
+// WriteTable 터미널용 표 형식으로 출력
+// 한글은 글자 폭이 달라 정렬이 어긋나므로 항목 이름은 마지막 열에 출력
+func WriteTable(w io.Writer, entries []Entry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tCATEGORY\tMODE\tRESOURCES\tAWS APIS\tRUNBOOK\tTITLE")
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.ID, e.Category, e.Mode, joinOrDash(e.Resources), joinOrDash(e.AWSActions), e.Runbook, e.Title)
+	}
+	return tw.Flush()
+}
+
+// WriteJSON JSON 배열로 출력
+func WriteJSON(w io.Writer, entries []Entry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// WriteMarkdown 카테고리별 Markdown 표로 출력
+func WriteMarkdown(w io.Writer, entries []Entry) error {
+	for i, category := range categoriesOf(entries) {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "## %s\n\n", category)
+		fmt.Fprintln(w, "| ID | 항목 | 점검 방식 | Kubernetes 리소스 | AWS API |")
+		fmt.Fprintln(w, "|----|------|-----------|-------------------|---------|")
+		for _, e := range entries {
+			if e.Category != category {
+				continue
+			}
+			fmt.Fprintf(w, "| [%s](%s) | %s | %s | %s | %s |\n", e.ID, e.Runbook, escapeCell(e.Title), ModeLabel(e.Mode),
+				codeList(e.Resources), codeList(e.AWSActions))
+		}
+	}
+	return nil
+}
+
+// categoriesOf 항목에 포함된 카테고리 (등록 순서)
+func categoriesOf(entries []Entry) []string {
+	var categories []string
+	for _, category := range checks.Categories {
+		for _, e := range entries {
+			if e.Category == category {
+				categories = append(categories, category)
+				break
+			}
+		}
+	}
+	return categories
+}
+
+func joinOrDash(list []string) string {
+	if len(list) == 0 {
+		return "-"
+	}
+	return strings.Join(list, ",")
+}
+
+func codeList(list []string) string {
+	if len(list) == 0 {
+		return "-"
+	}
+	quoted := make([]string, len(list))
+	for i, item := range list {
+		quoted[i] = "`" + item + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func escapeCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
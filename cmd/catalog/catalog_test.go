@@ -0,0 +1,144 @@
+package catalog_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/catalog"
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/testutils"
+)
+
+func TestUpdateNav(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "catalog_nav.yaml")
+	selected, err := checks.Select([]string{checks.CategoryCost}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries := catalog.Entries(selected)
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			mkdocs := tc["mkdocs"].(string)
+			for _, newline := range []string{"\n", "\r\n"} {
+				input := strings.ReplaceAll(mkdocs, "\n", newline)
+				output, err := catalog.UpdateNav([]byte(input), entries)
+				if tc["expect_error"].(bool) {
+					if err == nil {
+						t.Fatalf("Test '%s' failed: expected error", testName)
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("Test '%s' failed: unexpected error: %v", testName, err)
+				}
+
+				// 줄바꿈 형식 유지 확인 후 LF 기준으로 내용 비교
+				if newline == "\r\n" && bytes.Count(output, []byte("\n")) != bytes.Count(output, []byte("\r\n")) {
+					t.Errorf("Test '%s' failed: CRLF line endings not preserved", testName)
+				}
+				text := strings.ReplaceAll(string(output), "\r\n", "\n")
+				for _, expect := range testutils.ToStrings(tc["expect_contains"]) {
+					if !strings.Contains(text, expect) {
+						t.Errorf("Test '%s' failed: expected output to contain %q, got:\n%s", testName, expect, text)
+					}
+				}
+				for _, missing := range testutils.ToStrings(tc["expect_missing"]) {
+					if strings.Contains(text, missing) {
+						t.Errorf("Test '%s' failed: expected %q to be removed", testName, missing)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEntries(t *testing.T) {
+	for _, e := range catalog.Entries(checks.All) {
+		switch e.Mode {
+		case checks.ModeAutomatic, checks.ModeManual, checks.ModeMixed:
+		default:
+			t.Errorf("%s: invalid mode %q", e.ID, e.Mode)
+		}
+		if !strings.HasSuffix(e.Runbook, "/"+e.ID) {
+			t.Errorf("%s: unexpected runbook %s", e.ID, e.Runbook)
+		}
+	}
+
+	// 단건 조회만 하는 리소스도 필요한 리소스에 포함
+	selected, _ := checks.Select(nil, []string{"SEC-014"})
+	if resources := strings.Join(catalog.Entries(selected)[0].Resources, ","); resources != "pods,nodes" {
+		t.Errorf("SEC-014: expected pods,nodes, got %s", resources)
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "catalog_table.yaml")
+	// 열은 두 칸 이상의 공백으로 구분 (AWS APIS처럼 열 이름에 공백 하나는 허용)
+	columns := regexp.MustCompile(`\s{2,}`)
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			selected, err := checks.Select(nil, testutils.ToStrings(tc["checks"]))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			if err := catalog.WriteTable(&buf, catalog.Entries(selected)); err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+			if header := strings.Join(columns.Split(strings.TrimSpace(lines[0]), -1), "|"); header != strings.Join(testutils.ToStrings(tc["expect_header"]), "|") {
+				t.Errorf("Test '%s' failed: unexpected header %q", testName, header)
+			}
+			rows := tc["expect_rows"].([]interface{})
+			if len(lines)-1 != len(rows) {
+				t.Fatalf("Test '%s' failed: expected %d rows, got %d", testName, len(rows), len(lines)-1)
+			}
+			for i, row := range rows {
+				expect := strings.Join(testutils.ToStrings(row), "|")
+				if got := strings.Join(columns.Split(lines[i+1], -1), "|"); !strings.HasPrefix(got, expect+"|") {
+					t.Errorf("Test '%s' failed: expected row starting with %q, got %q", testName, expect, got)
+				}
+			}
+		})
+	}
+}
+
+// TestDocsInSync 저장소의 Runbook 인덱스와 mkdocs.yml nav가 등록된 체크 목록과 같은지 확인
+func TestDocsInSync(t *testing.T) {
+	entries := catalog.Entries(checks.All)
+
+	for rel, content := range catalog.RunbookIndexes(entries) {
+		current, err := os.ReadFile(filepath.Join("../../docs", rel))
+		if err != nil || !bytes.Equal(current, content) {
+			t.Errorf("docs/%s가 최신이 아닙니다: 'eks-checklist docs generate'로 다시 생성하세요", rel)
+		}
+	}
+	for _, e := range entries {
+		if _, err := os.Stat(filepath.Join("../../docs", catalog.RunbookPath(e))); err != nil {
+			t.Errorf("%s: Runbook 문서가 없습니다 (docs/%s)", e.ID, catalog.RunbookPath(e))
+		}
+	}
+
+	mkdocs, err := os.ReadFile("../../mkdocs.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated, err := catalog.UpdateNav(mkdocs, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(mkdocs, updated) {
+		t.Errorf("mkdocs.yml nav가 최신이 아닙니다: 'eks-checklist docs generate'로 다시 생성하세요")
+	}
+}
@@ -0,0 +1,123 @@
+package catalog
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"eks-checklist/cmd/checks"
+)
+
+// generatedNotice 생성된 문서 상단에 넣는 안내 문구
+const generatedNotice = "<!-- 이 파일은 'eks-checklist docs generate'로 생성됩니다. 직접 수정하지 마세요. -->\n"
+
+// runbookNavPattern mkdocs.yml nav에서 Runbook 섹션 시작 줄
+var runbookNavPattern = regexp.MustCompile(`^(\s*)- Runbook:\s*$`)
+
+// NavTitle 카테고리의 문서 섹션 이름 (Runbook 디렉터리 이름의 첫 글자를 대문자로)
+func NavTitle(category string) string {
+	dir := checks.RunbookDir(category)
+	if dir == "" {
+		return category
+	}
+	return strings.ToUpper(dir[:1]) + dir[1:]
+}
+
+// RunbookPath docs 디렉터리 기준 체크의 Runbook 문서 경로
+func RunbookPath(e Entry) string {
+	return path.Join("runbook", checks.RunbookDir(e.Category), e.ID+".md")
+}
+
+// RunbookIndexes docs 디렉터리 기준 경로별 Runbook 인덱스 문서 (전체 인덱스와 카테고리별 인덱스)
+func RunbookIndexes(entries []Entry) map[string][]byte {
+	indexes := make(map[string][]byte)
+
+	var all bytes.Buffer
+	all.WriteString(generatedNotice)
+	all.WriteString("# Runbook\n\n체크 항목별 점검 기준과 조치 방법입니다.\n")
+
+	for _, category := range categoriesOf(entries) {
+		dir := checks.RunbookDir(category)
+
+		var b bytes.Buffer
+		b.WriteString(generatedNotice)
+		fmt.Fprintf(&b, "# %s\n\n", NavTitle(category))
+		writeIndexTable(&b, entries, category, "")
+		indexes[path.Join("runbook", dir, "index.md")] = b.Bytes()
+
+		fmt.Fprintf(&all, "\n## [%s](%s/index.md)\n\n", NavTitle(category), dir)
+		writeIndexTable(&all, entries, category, dir+"/")
+	}
+	indexes["runbook/index.md"] = all.Bytes()
+	return indexes
+}
+
+func writeIndexTable(b *bytes.Buffer, entries []Entry, category, prefix string) {
+	b.WriteString("| ID | 항목 | 점검 방식 |\n")
+	b.WriteString("|----|------|-----------|\n")
+	for _, e := range entries {
+		if e.Category == category {
+			fmt.Fprintf(b, "| [%s](%s%s.md) | %s | %s |\n", e.ID, prefix, e.ID, escapeCell(e.Title), ModeLabel(e.Mode))
+		}
+	}
+}
+
+// UpdateNav mkdocs.yml의 nav 중 Runbook 섹션을 등록된 체크 목록으로 다시 생성
+// Runbook 섹션 외의 내용과 줄바꿈 형식(CRLF)은 그대로 유지
+func UpdateNav(mkdocs []byte, entries []Entry) ([]byte, error) {
+	newline := "\n"
+	if bytes.Contains(mkdocs, []byte("\r\n")) {
+		newline = "\r\n"
+	}
+	lines := strings.Split(strings.ReplaceAll(string(mkdocs), "\r\n", "\n"), "\n")
+
+	start := -1
+	var indent string
+	for i, line := range lines {
+		if m := runbookNavPattern.FindStringSubmatch(line); m != nil {
+			start, indent = i, m[1]
+			break
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("mkdocs.yml nav에서 '- Runbook:' 섹션을 찾을 수 없습니다")
+	}
+
+	// 들여쓰기가 Runbook 항목보다 깊은 줄까지가 Runbook 섹션
+	end := start + 1
+	for end < len(lines) {
+		line := lines[end]
+		if strings.TrimSpace(line) != "" && len(line)-len(strings.TrimLeft(line, " ")) <= len(indent) {
+			break
+		}
+		end++
+	}
+	// 섹션 뒤의 빈 줄은 섹션에 포함하지 않음
+	for end > start+1 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+
+	section := []string{indent + "- Runbook:", indent + "  - runbook/index.md"}
+	for _, category := range categoriesOf(entries) {
+		section = append(section, indent+"  - "+NavTitle(category)+":")
+		section = append(section, indent+"    - "+path.Join("runbook", checks.RunbookDir(category), "index.md"))
+		for _, e := range entries {
+			if e.Category == category {
+				section = append(section, indent+"    - "+navKey(e.ID+" "+e.Title)+": "+RunbookPath(e))
+			}
+		}
+	}
+
+	result := append(append(append([]string{}, lines[:start]...), section...), lines[end:]...)
+	return []byte(strings.Join(result, newline)), nil
+}
+
+// navKey YAML 키로 그대로 쓸 수 없는 문자가 있으면 따옴표로 감쌈
+func navKey(key string) string {
+	if strings.ContainsAny(key, ":#'\"{}[]") {
+		return `"` + strings.ReplaceAll(key, `"`, `\"`) + `"`
+	}
+	return key
+}
@@ -0,0 +1,57 @@
+package checks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"eks-checklist/cmd/common"
+)
+
+// Execute 체크를 실행하고, 실행 중 panic이 발생하면 프로세스를 종료하지 않고 실패 결과로 변환
+// ctx가 만료되거나 취소되면 체크가 끝나기를 기다리지 않고 시간 초과 결과를 반환
+// (ctx를 확인하지 않는 호출은 백그라운드에서 끝날 때까지 실행되며 결과는 버려짐)
+func (c Check) Execute(ctx context.Context, env *Env) common.CheckResult {
+	done := make(chan common.CheckResult, 1)
+	go func() {
+		done <- c.safeRun(ctx, env)
+	}()
+
+	select {
+	case result := <-done:
+		// ctx 만료로 API 호출이 실패한 경우도 일반 실패가 아닌 시간 초과로 표시
+		if err := ctx.Err(); err != nil && !result.Passed {
+			return c.TimedOutResult(err)
+		}
+		return result
+	case <-ctx.Done():
+		return c.TimedOutResult(ctx.Err())
+	}
+}
+
+func (c Check) safeRun(ctx context.Context, env *Env) (result common.CheckResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = common.CheckResult{
+				CheckName:  c.Title(),
+				FailureMsg: fmt.Sprintf("%s 검사 실패 : %v", c.Title(), r),
+				Runbook:    c.Runbook(),
+			}
+		}
+	}()
+	return c.Run(ctx, env)
+}
+
+// TimedOutResult 시간 초과 또는 취소로 완료하지 못한 체크 결과
+func (c Check) TimedOutResult(err error) common.CheckResult {
+	msg := "체크 실행 시간이 초과되었습니다."
+	if errors.Is(err, context.Canceled) {
+		msg = "체크 실행이 취소되었습니다."
+	}
+	return common.CheckResult{
+		CheckName:  c.Title(),
+		TimedOut:   true,
+		FailureMsg: msg,
+		Runbook:    c.Runbook(),
+	}
+}
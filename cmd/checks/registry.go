@@ -0,0 +1,432 @@
+package checks
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/cost"
+	"eks-checklist/cmd/general"
+	"eks-checklist/cmd/network"
+	"eks-checklist/cmd/reliability"
+	"eks-checklist/cmd/scalability"
+	"eks-checklist/cmd/security"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+// 카테고리 이름 (출력 헤더에 그대로 사용)
+const (
+	CategoryGeneral     = "General Check"
+	CategorySecurity    = "Security Check"
+	CategoryScalability = "Scalability Check"
+	CategoryStability   = "Stability Check"
+	CategoryNetwork     = "Network Check"
+	CategoryCost        = "Cost-Optimized Check"
+)
+
+// Categories 출력 순서대로 정렬된 카테고리 목록
+var Categories = []string{
+	CategoryGeneral,
+	CategorySecurity,
+	CategoryScalability,
+	CategoryStability,
+	CategoryNetwork,
+	CategoryCost,
+}
+
+// Env 체크 실행에 필요한 클라이언트와 클러스터 정보
+type Env struct {
+	Client        kubernetes.Interface
+	DynamicClient dynamic.Interface
+	// MetadataClient 본문 없이 메타데이터만 조회하는 클라이언트 (SEC-017이 Secret 값을 읽지 않고 소유자만 확인)
+	MetadataClient metadata.Interface
+	AWSConfig      aws.Config
+	Cluster        *types.Cluster // DescribeCluster 결과
+	ClusterName    string
+	// EvidenceDir 체크가 수집한 증적 파일을 저장할 디렉터리 (체크별로 <EvidenceDir>/<체크 ID>에 저장)
+	// 비어 있으면 output/<클러스터 이름>/evidence
+	EvidenceDir string
+	// ClusterAdminAllowlist cluster 범위 AmazonEKSClusterAdminPolicy 연결을 허용할 principal ARN 패턴 (SEC-002, *는 임의의 문자열)
+	ClusterAdminAllowlist []string
+	// RestrictSecurityGroupEgress 인터넷(0.0.0.0/0, ::/0)으로 열린 보안 그룹 아웃바운드 규칙을 실패로 판정 (SEC-019)
+	RestrictSecurityGroupEgress bool
+}
+
+// Evidence 체크 ID별 증적 저장 디렉터리
+func (e *Env) Evidence(id string) string {
+	dir := e.EvidenceDir
+	if dir == "" {
+		dir = filepath.Join("output", e.ClusterName, "evidence")
+	}
+	return filepath.Join(dir, id)
+}
+
+// runbookCategories 카테고리별 Runbook 경로
+var runbookCategories = map[string]string{
+	CategoryGeneral:     "general",
+	CategorySecurity:    "security",
+	CategoryScalability: "scalability",
+	CategoryStability:   "reliability",
+	CategoryNetwork:     "network",
+	CategoryCost:        "cost",
+}
+
+// 체크 점검 방식
+const (
+	// ModeAutomatic 결과를 자동으로 판정
+	ModeAutomatic = "automatic"
+	// ModeManual 정보를 수집해 보여주고 판정은 사람이 수행
+	ModeManual = "manual"
+	// ModeMixed 자동으로 판정할 수 있는 부분만 판정하고 나머지는 수동 확인
+	ModeMixed = "automatic/manual"
+)
+
+// Check 등록된 체크 항목
+type Check struct {
+	ID       string
+	Name     string
+	Category string
+	// Mode 점검 방식 (ModeAutomatic, ModeManual, ModeMixed)
+	Mode string
+	// Resources 체크가 조회하는 Kubernetes 리소스 (resource.group 형식, core 그룹은 resource만 표기)
+	Resources []string
+	// Gets 이름을 지정해 단건 조회(get)만 하는 리소스 (Resources와 같은 형식, 목록 조회 권한은 필요 없음)
+	Gets []string
+	// RequiresAWS AWS API 조회가 필요한 체크 여부
+	RequiresAWS bool
+	// AWSActions 체크가 호출하는 AWS API (IAM 정책 액션 형식)
+	AWSActions []string
+	Run        func(ctx context.Context, env *Env) common.CheckResult
+}
+
+// Title 결과 출력에 사용하는 체크 이름 ("[ID] 이름")
+func (c Check) Title() string {
+	return "[" + c.ID + "] " + c.Name
+}
+
+// Runbook 체크의 Runbook 주소
+func (c Check) Runbook() string {
+	return "https://fitcloud.github.io/eks-checklist/runbook/" + runbookCategories[c.Category] + "/" + c.ID
+}
+
+// RunbookDir 카테고리의 Runbook 문서 디렉터리 이름 (docs/runbook/<디렉터리>)
+func RunbookDir(category string) string {
+	return runbookCategories[category]
+}
+
+// NotApplicableResult 실행하지 않은 체크를 적용 불가(N/A)로 표시한 결과
+func (c Check) NotApplicableResult(reason string) common.CheckResult {
+	return common.CheckResult{
+		CheckName:     c.Title(),
+		NotApplicable: true,
+		FailureMsg:    reason,
+		Runbook:       c.Runbook(),
+	}
+}
+
+// Reads 체크가 주어진 Kubernetes 리소스를 조회하는지 확인
+func (c Check) Reads(resource string) bool {
+	for _, r := range c.Resources {
+		if r == resource {
+			return true
+		}
+	}
+	return false
+}
+
+// All 등록된 전체 체크 목록 (카테고리 및 출력 순서 유지)
+var All = []Check{
+	// 코드형 인프라 (EKS 클러스터, 애플리케이션 배포)
+	{ID: "GEN-001", Name: "코드형 인프라 (EKS 클러스터, 애플리케이션 배포)", Category: CategoryGeneral, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return general.CheckIAC()
+	}},
+	// GitOps 적용
+	{ID: "GEN-002", Name: "GitOps 적용", Category: CategoryGeneral, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return general.CheckGitOps()
+	}},
+	// 컨테이너 이미지 태그에 latest 미사용
+	{ID: "GEN-003", Name: "컨테이너 이미지 태그에 latest 미사용", Category: CategoryGeneral, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return general.CheckImageTag(ctx, env.Client)
+	}},
+
+	// EKS 클러스터 API 엔드포인트 접근 제어(공인망, 사설망, IP 기반 제어) - Automatic
+	{ID: "SEC-001", Name: "EKS 클러스터 API 엔드포인트 접근 제어(공인망, 사설망, IP 기반 제어)", Category: CategorySecurity, Mode: ModeAutomatic, RequiresAWS: true, AWSActions: []string{"eks:DescribeCluster"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckEndpointPublicAccess(security.EksCluster{Cluster: env.Cluster})
+	}},
+	// 클러스터 접근 제어(Access entries, aws-auth 컨피그맵) - Automatic/Manual
+	{ID: "SEC-002", Name: "클러스터 접근 제어(Access entries, aws-auth 컨피그맵)", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"configmaps", "clusterroles.rbac.authorization.k8s.io", "clusterrolebindings.rbac.authorization.k8s.io"}, Gets: []string{"configmaps"}, RequiresAWS: true, AWSActions: []string{"eks:ListAccessEntries", "eks:DescribeAccessEntry", "eks:ListAssociatedAccessPolicies"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckAccessControl(ctx, env.Client, env.AWSConfig, env.Cluster, env.ClusterName, env.ClusterAdminAllowlist, env.Evidence("SEC-002"))
+	}},
+	// IRSA 또는 Pod Identity 기반 권한 부여 - Automatic
+	{ID: "SEC-003", Name: "IRSA 또는 EKS Pod Identity 기반 권한 부여", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"serviceaccounts", "pods", "namespaces"}, Gets: []string{"daemonsets.apps"}, RequiresAWS: true, AWSActions: []string{"iam:ListOpenIDConnectProviders", "iam:GetRole", "iam:ListAttachedRolePolicies", "iam:GetPolicy", "iam:GetPolicyVersion", "iam:ListRolePolicies", "iam:GetRolePolicy", "eks:ListPodIdentityAssociations", "eks:DescribePodIdentityAssociation", "eks:DescribeAddon"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckIRSAAndPodIdentity(ctx, env.Client, env.AWSConfig, security.EksCluster{Cluster: env.Cluster})
+	}},
+	// 데이터 플레인 노드에 필수로 필요한 IAM 권한만 부여 - Automatic
+	{ID: "SEC-004", Name: "데이터 플레인 노드에 필수로 필요한 IAM 권한만 부여", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"nodes"}, RequiresAWS: true, AWSActions: []string{"ec2:DescribeInstances", "iam:GetInstanceProfile", "iam:GetRole", "iam:ListAttachedRolePolicies", "iam:GetPolicy", "iam:GetPolicyVersion", "iam:ListRolePolicies", "iam:GetRolePolicy"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckNodeIAMRoles(ctx, env.Client, env.AWSConfig)
+	}},
+	// 루트 유저가 아닌 유저로 컨테이너 실행 - Automatic
+	{ID: "SEC-005", Name: "루트 유저가 아닌 유저로 컨테이너 실행", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckContainerExecutionUser(ctx, env.Client)
+	}},
+	// 멀티 태넌시 적용 유무 - Manual
+	{ID: "SEC-006", Name: "멀티 태넌시 적용 유무", Category: CategorySecurity, Mode: ModeManual, Resources: []string{"namespaces", "networkpolicies.networking.k8s.io", "rolebindings.rbac.authorization.k8s.io", "clusterrolebindings.rbac.authorization.k8s.io", "resourcequotas", "limitranges", "serviceaccounts", "priorityclasses.scheduling.k8s.io"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckMultitenancy(ctx, env.Client, env.AWSConfig, env.Evidence("SEC-006"))
+	}},
+	// Audit 로그 활성화 - Automatic
+	{ID: "SEC-007", Name: "Audit 로그 활성화", Category: CategorySecurity, Mode: ModeAutomatic, RequiresAWS: true, AWSActions: []string{"eks:DescribeCluster"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckAuditLoggingEnabled(&security.EksCluster{Cluster: env.Cluster})
+	}},
+	// 비정상 접근에 대한 알림 설정 - Manual
+	{ID: "SEC-008", Name: "비정상 접근에 대한 알림 설정", Category: CategorySecurity, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckAccessAlarm()
+	}},
+	// Pod-to-Pod 접근 제어 - Automatic/Manual
+	{ID: "SEC-009", Name: "Pod-to-Pod 접근 제어", Category: CategorySecurity, Mode: ModeMixed, Resources: []string{"networkpolicies.networking.k8s.io"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckPodToPodNetworkPolicy(ctx, env.Client, env.Evidence("SEC-009"))
+	}},
+	// PV 암호화 - Automatic
+	{ID: "SEC-010", Name: "PV 암호화", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"persistentvolumes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckPVEcryption(ctx, env.Client)
+	}},
+	// Secret 객체 암호화 - Automatic
+	{ID: "SEC-011", Name: "Secret 객체 암호화", Category: CategorySecurity, Mode: ModeAutomatic, RequiresAWS: true, AWSActions: []string{"kms:DescribeKey", "kms:GetKeyRotationStatus", "kms:GetKeyPolicy"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckSecretEncryption(ctx, env.AWSConfig, security.EksCluster{Cluster: env.Cluster})
+	}},
+	// 데이터 플레인 사설망 - Automatic
+	{ID: "SEC-012", Name: "데이터 플레인 사설망", Category: CategorySecurity, Mode: ModeAutomatic, RequiresAWS: true, AWSActions: []string{"eks:ListNodegroups", "eks:DescribeNodegroup", "ec2:DescribeRouteTables"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.DataplanePrivateCheck(ctx, security.EksCluster{Cluster: env.Cluster}, env.AWSConfig)
+	}},
+	// 컨테이너 이미지 정적 분석 - Manual
+	{ID: "SEC-013", Name: "컨테이너 이미지 정적 분석", Category: CategorySecurity, Mode: ModeManual, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckImageStaticAnalysis(ctx, env.Client, env.AWSConfig, env.Evidence("SEC-013"))
+	}},
+	// 읽기 전용 파일시스템 사용 - Automatic
+	{ID: "SEC-014", Name: "읽기 전용 파일시스템 사용", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"pods", "nodes"}, Gets: []string{"nodes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.ReadnonlyFilesystemCheck(ctx, env.Client)
+	}},
+	// Kubernetes RBAC 최소 권한 부여 - Automatic
+	{ID: "SEC-015", Name: "Kubernetes RBAC 최소 권한 부여", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"clusterroles.rbac.authorization.k8s.io", "roles.rbac.authorization.k8s.io", "clusterrolebindings.rbac.authorization.k8s.io", "rolebindings.rbac.authorization.k8s.io"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckRBACRisk(ctx, env.Client)
+	}},
+	// Pod Security Standards 적용 - Automatic
+	{ID: "SEC-016", Name: "Pod Security Standards 적용", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"namespaces", "pods", "deployments.apps", "statefulsets.apps", "daemonsets.apps", "replicasets.apps", "jobs.batch", "cronjobs.batch"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckPodSecurityStandards(ctx, env.Client)
+	}},
+	// Secret 노출 최소화(환경 변수, 외부 시크릿 저장소) - Automatic
+	{ID: "SEC-017", Name: "Secret 노출 최소화(환경 변수, 외부 시크릿 저장소)", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"secrets", "pods", "deployments.apps", "statefulsets.apps", "daemonsets.apps", "replicasets.apps", "jobs.batch", "cronjobs.batch"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckSecretExposure(ctx, env.Client, env.MetadataClient)
+	}},
+	// 인스턴스 메타데이터(IMDS) 접근 제한 - Automatic
+	{ID: "SEC-018", Name: "인스턴스 메타데이터(IMDS) 접근 제한", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"nodes", "pods", "ec2nodeclasses.karpenter.k8s.aws"}, RequiresAWS: true, AWSActions: []string{"ec2:DescribeInstances", "ec2:DescribeLaunchTemplateVersions", "eks:ListNodegroups", "eks:DescribeNodegroup"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckIMDSHardening(ctx, env.Client, env.DynamicClient, env.AWSConfig, security.EksCluster{Cluster: env.Cluster})
+	}},
+	// 보안 그룹 노출 최소화(클러스터, 노드 보안 그룹) - Automatic
+	{ID: "SEC-019", Name: "보안 그룹 노출 최소화(클러스터, 노드 보안 그룹)", Category: CategorySecurity, Mode: ModeAutomatic, Resources: []string{"nodes"}, RequiresAWS: true, AWSActions: []string{"ec2:DescribeInstances", "ec2:DescribeLaunchTemplateVersions", "ec2:DescribeSecurityGroups", "eks:ListNodegroups", "eks:DescribeNodegroup"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return security.CheckSecurityGroupExposure(ctx, env.Client, env.AWSConfig, security.EksCluster{Cluster: env.Cluster}, env.RestrictSecurityGroupEgress)
+	}},
+
+	// Karpenter 사용 - Automatic
+	{ID: "SCL-001", Name: "Karpenter 사용", Category: CategoryScalability, Mode: ModeAutomatic, Resources: []string{"deployments.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.GetKarpenter(ctx, env.Client)
+	}},
+	// Karpenter 전용 노드 그룹 혹은 Fargate 사용 - Automatic
+	{ID: "SCL-002", Name: "Karpenter 전용 노드 그룹 혹은 Fargate 사용", Category: CategoryScalability, Mode: ModeAutomatic, Resources: []string{"nodes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckNodeGroupUsage(ctx, env.Client)
+	}},
+	// Spot 노드 사용시 Spot 중지 핸들러 적용 - Automatic
+	{ID: "SCL-003", Name: "Spot 노드 사용시 Spot 중지 핸들러 적용", Category: CategoryScalability, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckSpotNodeTerminationHandler(ctx, env.Client)
+	}},
+	// 중요 Pod에 노드 삭제 방지용 Label 부여 - Manual
+	{ID: "SCL-004", Name: "중요 Pod에 노드 삭제 방지용 Label 부여", Category: CategoryScalability, Mode: ModeManual, Resources: []string{"pods", "nodes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckImportantPodProtection(ctx, env.Client, env.AWSConfig, env.Evidence("SCL-004"))
+	}},
+	// Application에 Graceful shutdown 적용 - Manual
+	{ID: "SCL-005", Name: "Application에 Graceful shutdown 적용", Category: CategoryScalability, Mode: ModeManual, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckGracefulShutdown(ctx, env.Client, env.AWSConfig, env.Evidence("SCL-005"))
+	}},
+	// 노드 확장/축소 정책 적용 - Manual
+	{ID: "SCL-006", Name: "노드 확장/축소 정책 적용", Category: CategoryScalability, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckNodeScalingPolicy()
+	}},
+	// 다양한 인스턴스 타입 사용 - Automatic
+	{ID: "SCL-007", Name: "다양한 인스턴스 타입 사용", Category: CategoryScalability, Mode: ModeAutomatic, Resources: []string{"nodes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return scalability.CheckInstanceTypes(ctx, env.Client)
+	}},
+
+	// 싱글톤 Pod 미사용 - Automatic
+	{ID: "REL-001", Name: "싱글톤 Pod 미사용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.SingletonPodCheck(ctx, env.Client)
+	}},
+	// 2개 이상의 Pod 복제본 사용 - Automatic
+	{ID: "REL-002", Name: "2개 이상의 Pod 복제본 사용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"replicasets.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.PodReplicaSetCheck(ctx, env.Client)
+	}},
+	// 동일한 역할을 하는 Pod를 다수의 노드에 분산 배포 - Automatic
+	{ID: "REL-003", Name: "동일한 역할을 하는 Pod를 다수의 노드에 분산 배포", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckPodDistributionAndAffinity(ctx, env.Client)
+	}},
+	// HPA 적용 - Automatic
+	{ID: "REL-004", Name: "HPA 적용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"deployments.apps", "horizontalpodautoscalers.autoscaling"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckHpa(ctx, env.Client)
+	}},
+	// Probe(Startup, Readiness, Liveness) 적용 - Automatic
+	{ID: "REL-005", Name: "Probe(Startup, Readiness, Liveness) 적용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckProbe(ctx, env.Client)
+	}},
+	// 중요 워크로드에 대한 PDB(Pod Distruption Budget) 적용 - Automatic/Manual
+	{ID: "REL-006", Name: "중요 워크로드에 대한 PDB(Pod Distruption Budget) 적용", Category: CategoryStability, Mode: ModeMixed, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckPDB()
+	}},
+	// 애플리케이션에 적절한 CPU/RAM 할당 - Automatic/Manual
+	{ID: "REL-007", Name: "애플리케이션에 적절한 CPU/RAM 할당", Category: CategoryStability, Mode: ModeMixed, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckResourceAllocation(ctx, env.Client, env.AWSConfig, env.Evidence("REL-007"))
+	}},
+	// 애플리케이션 중요도에 따른 QoS 적용 - Automatic/Manual
+	{ID: "REL-008", Name: "애플리케이션 중요도에 따른 QoS 적용", Category: CategoryStability, Mode: ModeMixed, Resources: []string{"pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckQoSClass(ctx, env.Client, env.AWSConfig, env.Evidence("REL-008"))
+	}},
+	// 인프라 및 애플리케이션 모니터링 스택 적용 - Manual
+	{ID: "REL-009", Name: "인프라 및 애플리케이션 모니터링 스택 적용", Category: CategoryStability, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckNodeScalingPolicy()
+	}},
+	// 반영구 저장소에 애플리케이션 로그 저장 - Manual
+	{ID: "REL-010", Name: "반영구 저장소에 애플리케이션 로그 저장", Category: CategoryStability, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckApplicationLogs()
+	}},
+	// 오토스케일링 그룹 기반 관리형 노드 그룹 생성 - Automatic
+	{ID: "REL-011", Name: "오토스케일링 그룹 기반 관리형 노드 그룹 생성", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"nodes"}, RequiresAWS: true, AWSActions: []string{"eks:DescribeNodegroup", "autoscaling:DescribeAutoScalingGroups"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckAutoScaledManagedNodeGroup(ctx, env.Client, env.AWSConfig, env.ClusterName)
+	}},
+	// Cluster Autoscaler 적용 - Automatic
+	{ID: "REL-012", Name: "Cluster Autoscaler 적용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"deployments.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckClusterAutoscalerEnabled(ctx, env.Client)
+	}},
+	// Karpenter 기반 노드 생성 - Automatic
+	{ID: "REL-013", Name: "Karpenter 기반 노드 생성", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"deployments.apps", "nodeclaims.karpenter.k8s.aws"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckKarpenterNode(ctx, scalability.GetKarpenter(ctx, env.Client), env.DynamicClient)
+	}},
+	// 다수의 가용 영역에 데이터 플레인 노드 배포 - Automatic
+	{ID: "REL-014", Name: "다수의 가용 영역에 데이터 플레인 노드 배포", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"nodes"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckNodeMultiAZ(ctx, env.Client)
+	}},
+	// PV 사용시 volume affinity 위반 사항 체크 - Manual (PV 어피니티 전부다 출력)
+	{ID: "REL-015", Name: "PV 사용시 volume affinity 위반 사항 체크", Category: CategoryStability, Mode: ModeManual, Resources: []string{"persistentvolumeclaims", "persistentvolumes", "pods"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckVolumeAffinity(ctx, env.Client, env.AWSConfig, env.Evidence("REL-015"))
+	}},
+	// CoreDNS에 HPA 적용 - Automatic
+	{ID: "REL-016", Name: "CoreDNS에 HPA 적용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"horizontalpodautoscalers.autoscaling"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckCoreDNSHpa(ctx, env.Client)
+	}},
+	// DNS 캐시 적용 - Automatic
+	{ID: "REL-017", Name: "DNS 캐시 적용", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"configmaps"}, Gets: []string{"configmaps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckCoreDNSCache(ctx, env.Client)
+	}},
+	// Karpenter 사용시 DaemonSet에 Priority Class 부여 - Automatic
+	{ID: "REL-018", Name: "Karpenter 사용시 DaemonSet에 Priority Class 부여", Category: CategoryStability, Mode: ModeAutomatic, Resources: []string{"deployments.apps", "daemonsets.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return reliability.CheckDaemonSetPriorityClass(ctx, scalability.GetKarpenter(ctx, env.Client), env.Client)
+	}},
+
+	// VPC 서브넷에 충분한 IP 대역대 확보 - Automatic/Manual
+	{ID: "NET-001", Name: "VPC 서브넷에 충분한 IP 대역대 확보", Category: CategoryNetwork, Mode: ModeMixed, RequiresAWS: true, AWSActions: []string{"ec2:DescribeSubnets"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckVpcSubnetIpCapacity(ctx, network.EksCluster{Cluster: env.Cluster}, env.AWSConfig)
+	}},
+	// Pod에 부여할 IP 부족시 알림 설정 - Manual
+	{ID: "NET-002", Name: "Pod에 부여할 IP 부족시 알림 설정", Category: CategoryNetwork, Mode: ModeManual, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckPodIPAlarm()
+	}},
+	// VPC CNI의 Prefix 모드 사용 - Automatic
+	{ID: "NET-003", Name: "VPC CNI의 Prefix 모드 사용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"daemonsets.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckVpcCniPrefixMode(ctx, env.Client)
+	}},
+	// 사용 사례에 맞는 로드밸런서 사용(ALB or NLB) - Manual
+	{ID: "NET-004", Name: "사용 사례에 맞는 로드밸런서 사용(ALB or NLB)", Category: CategoryNetwork, Mode: ModeManual, Resources: []string{"ingresses.networking.k8s.io"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckLoadBalancerUsage(ctx, env.Client, env.AWSConfig, env.Evidence("NET-004"))
+	}},
+	// AWS Load Balancer Controller 사용 - Automatic
+	{ID: "NET-005", Name: "AWS Load Balancer Controller 사용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"deployments.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckAwsLoadBalancerController(ctx, env.Client)
+	}},
+	// ALB/NLB의 대상으로 Pod의 IP 사용 - Automatic
+	{ID: "NET-006", Name: "ALB/NLB의 대상으로 Pod의 IP 사용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"deployments.apps", "ingresses.networking.k8s.io", "services"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckAwsLoadBalancerPodIp(ctx, network.CheckAwsLoadBalancerController(ctx, env.Client), env.Client)
+	}},
+	// Pod Readiness Gate 적용 - Automatic
+	{ID: "NET-007", Name: "Pod Readiness Gate 적용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"deployments.apps", "namespaces"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckReadinessGateEnabled(ctx, network.CheckAwsLoadBalancerController(ctx, env.Client), env.Client)
+	}},
+	// kube-proxy에 IPVS 모드 적용 - Automatic
+	{ID: "NET-008", Name: "kube-proxy에 IPVS 모드 적용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"configmaps"}, Gets: []string{"configmaps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.CheckKubeProxyIPVSMode(ctx, env.Client)
+	}},
+	// Endpoint 대신 EndpointSlices 사용 - Automatic
+	{ID: "NET-009", Name: "Endpoint 대신 EndpointSlices 사용", Category: CategoryNetwork, Mode: ModeAutomatic, Resources: []string{"endpoints", "endpointslices.discovery.k8s.io"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return network.EndpointSlicesCheck(ctx, env.Client)
+	}},
+
+	// EKS용 Kubecost 설치 - Automatic
+	{ID: "COST-001", Name: "EKS용 Kubecost 설치", Category: CategoryCost, Mode: ModeAutomatic, Resources: []string{"deployments.apps"}, Run: func(ctx context.Context, env *Env) common.CheckResult {
+		return cost.GetKubecost(ctx, env.Client)
+	}},
+}
+
+// ByCategory 카테고리에 속한 체크 목록 반환
+func ByCategory(category string) []Check {
+	var result []Check
+	for _, check := range All {
+		if check.Category == category {
+			result = append(result, check)
+		}
+	}
+	return result
+}
+
+// Select 카테고리와 체크 ID 조건에 맞는 체크 목록 (등록 순서 유지)
+// 둘 다 비어 있으면 전체, 함께 지정하면 둘 다 만족하는 체크만 반환하며 알 수 없는 값은 오류
+func Select(categories, ids []string) ([]Check, error) {
+	categorySet := make(map[string]bool)
+	for _, category := range categories {
+		if len(ByCategory(category)) == 0 {
+			return nil, fmt.Errorf("알 수 없는 카테고리: %s", category)
+		}
+		categorySet[category] = true
+	}
+	idSet := make(map[string]bool)
+	for _, id := range ids {
+		if _, ok := Find(id); !ok {
+			return nil, fmt.Errorf("알 수 없는 체크 ID: %s", id)
+		}
+		idSet[id] = true
+	}
+
+	var selected []Check
+	for _, category := range Categories {
+		if len(categorySet) > 0 && !categorySet[category] {
+			continue
+		}
+		for _, check := range ByCategory(category) {
+			if len(idSet) > 0 && !idSet[check.ID] {
+				continue
+			}
+			selected = append(selected, check)
+		}
+	}
+	return selected, nil
+}
+
+// Find ID로 체크를 찾음
+func Find(id string) (Check, bool) {
+	for _, check := range All {
+		if check.ID == id {
+			return check, true
+		}
+	}
+	return Check{}, false
+}
@@ -0,0 +1,27 @@
+package checks
+
+// runtimeResources 매니페스트에 포함되지 않고 클러스터가 실행 중일 때만 존재하는 리소스
+var runtimeResources = []string{
+	"nodes",
+	"nodeclaims.karpenter.k8s.aws",
+	"endpoints",
+	"endpointslices.discovery.k8s.io",
+}
+
+// StaticSkipReason 매니페스트만으로 평가할 수 없는 체크의 N/A 사유 반환 (평가 가능하면 빈 문자열)
+func StaticSkipReason(c Check) string {
+	if c.RequiresAWS {
+		return "AWS API 조회가 필요한 항목으로 매니페스트 스캔에서는 평가하지 않습니다."
+	}
+
+	// Pod를 함께 조회하는 체크는 노드 정보 없이도 Pod 기준으로 평가 가능
+	if c.Reads("pods") {
+		return ""
+	}
+	for _, resource := range runtimeResources {
+		if c.Reads(resource) {
+			return "실행 중인 클러스터의 " + resource + " 리소스가 필요한 항목으로 매니페스트 스캔에서는 평가하지 않습니다."
+		}
+	}
+	return ""
+}
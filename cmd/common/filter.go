@@ -12,6 +12,12 @@ func SetOutputFilter(filter string) {
 	OutputFilter = strings.ToLower(filter)
 }
 
+// ShouldPrintNotApplicable는 적용 불가(N/A) 결과를 출력할지 결정합니다
+// 상태 필터를 지정한 경우에는 N/A 결과를 출력하지 않습니다
+func ShouldPrintNotApplicable() bool {
+	return OutputFilter == "" || OutputFilter == "all"
+}
+
 // ShouldPrintResult는 필터에 따라 결과를 출력할지 결정합니다
 func ShouldPrintResult(passed bool, manual bool) bool {
 	if OutputFilter == "" || OutputFilter == "all" {
@@ -29,3 +35,18 @@ func ShouldPrintResult(passed bool, manual bool) bool {
 
 	return OutputFilter == resultType
 }
+
+// ResultStatus는 결과 상태를 필터 값과 같은 형식으로 반환합니다 ("pass", "fail", "manual", "n/a", "timeout")
+func ResultStatus(r CheckResult) string {
+	switch {
+	case r.NotApplicable:
+		return "n/a"
+	case r.TimedOut:
+		return "timeout"
+	case r.Passed:
+		return "pass"
+	case r.Manual:
+		return "manual"
+	}
+	return "fail"
+}
@@ -3,24 +3,61 @@ package common
 import (
 	"fmt"
 	"html/template"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"eks-checklist/cmd/runbook"
+	"eks-checklist/templates"
+
 	"github.com/SebastiaanKlippert/go-wkhtmltopdf"
 )
 
 // OutputFormat은 현재 출력 형식 설정을 저장합니다
 var (
 	OutputFormat string // "text", "html", "pdf"
+	// InlineRunbook HTML/PDF 보고서에 통과하지 못한 체크의 런북 내용을 포함할지 여부
+	InlineRunbook bool
+	// TemplatePath 사용자 지정 HTML 보고서 템플릿 경로 (비어 있으면 바이너리에 포함된 기본 템플릿)
+	TemplatePath string
+	// ReportDir HTML/PDF 보고서를 저장할 디렉터리 (없으면 생성)
+	ReportDir = "output"
 )
 
+// ResourcePreviewLimit 보고서에 체크별로 미리 표시하는 리소스 수
+// 나머지는 보고서에 포함된 결과 데이터에서 '더 보기'로 나누어 표시 (수천 개의 리소스를 한 번에 그리지 않음)
+const ResourcePreviewLimit = 50
+
 // SetOutputFormat은 출력 형식을 설정
 func SetOutputFormat(format string) {
 	OutputFormat = strings.ToLower(format)
 }
 
+// SetTemplatePath 사용자 지정 HTML 보고서 템플릿 경로 설정
+func SetTemplatePath(path string) {
+	TemplatePath = path
+}
+
+// SetReportDir HTML/PDF 보고서 저장 디렉터리 설정
+func SetReportDir(dir string) {
+	ReportDir = dir
+}
+
+// reportPath 보고서 저장 경로 (저장 디렉터리가 없으면 생성)
+func reportPath(now time.Time, ext string) (string, error) {
+	if err := os.MkdirAll(ReportDir, os.ModePerm); err != nil {
+		return "", fmt.Errorf("보고서 디렉터리 생성 오류: %v", err)
+	}
+	return filepath.Join(ReportDir, "eks-checklist-report-"+now.Format("20060102-150405")+ext), nil
+}
+
+// SetInlineRunbook HTML/PDF 보고서에 런북 내용 포함 여부 설정
+func SetInlineRunbook(inline bool) {
+	InlineRunbook = inline
+}
+
 // HTMLTemplateData HTML 템플릿에 사용될 데이터 구조
 type HTMLTemplateData struct {
 	Title         string
@@ -31,14 +68,48 @@ type HTMLTemplateData struct {
 	HasCategory   bool
 	CategoryOrder []string
 	SortByStatus  bool
+	// ResultData 보고서에 JSON으로 포함하는 전체 결과 (클라이언트 측 검색, 필터, 리소스 더 보기에 사용)
+	ResultData ReportData
+	// ResourcePreviewLimit 체크별로 미리 표시하는 리소스 수
+	ResourcePreviewLimit int
+}
+
+// ReportData 보고서에 포함하는 결과 데이터
+type ReportData struct {
+	Title   string            `json:"title"`
+	Date    string            `json:"date"`
+	Summary SummaryData       `json:"summary"`
+	Results []CheckResultHTML `json:"results"`
 }
 
 // SummaryData 요약 데이터 구조
 type SummaryData struct {
-	PassCount   int
-	FailCount   int
-	ManualCount int
-	Total       int
+	PassCount   int `json:"pass"`
+	FailCount   int `json:"fail"`
+	ManualCount int `json:"manual"`
+	// NotApplicableCount 적용 불가(N/A) 체크 수 (Total에는 포함하지 않음)
+	NotApplicableCount int `json:"notApplicable"`
+	// TimedOutCount 시간 초과 체크 수 (FailCount에 포함)
+	TimedOutCount int `json:"timedOut"`
+	Total         int `json:"total"`
+}
+
+// templateFuncs 보고서 템플릿에서 사용할 수 있는 함수
+var templateFuncs = template.FuncMap{
+	// preview 미리 표시할 리소스 (앞에서부터 ResourcePreviewLimit개)
+	"preview": func(resources []string) []string {
+		if len(resources) > ResourcePreviewLimit {
+			return resources[:ResourcePreviewLimit]
+		}
+		return resources
+	},
+	// remaining 미리 표시하지 않은 리소스 수
+	"remaining": func(resources []string) int {
+		if len(resources) > ResourcePreviewLimit {
+			return len(resources) - ResourcePreviewLimit
+		}
+		return 0
+	},
 }
 
 // 결과를 저장할 배열
@@ -66,24 +137,18 @@ func AddResultForHTML(r CheckResult, category string) {
 	}
 
 	// 필터 기준에 따라 결과를 저장할지 확인
-	if !ShouldPrintResult(r.Passed, r.Manual) {
+	if r.NotApplicable {
+		if !ShouldPrintNotApplicable() {
+			return
+		}
+	} else if !ShouldPrintResult(r.Passed, r.Manual) {
 		return
 	}
 
-	status := "PASS"
-	statusClass := "success" // bootstrap 클래스에 맞게 수정
-
-	if !r.Passed {
-		if r.Manual {
-			status = "MANUAL"
-			statusClass = "warning" // bootstrap 경고 클래스
-		} else {
-			status = "FAIL"
-			statusClass = "danger" // bootstrap 위험 클래스
-		}
-	}
+	status, statusClass := htmlStatus(r)
 
 	htmlResult := CheckResultHTML{
+		Index:       len(htmlResults),
 		CheckName:   r.CheckName,
 		Status:      status,
 		StatusClass: statusClass,
@@ -91,6 +156,7 @@ func AddResultForHTML(r CheckResult, category string) {
 		Resources:   r.Resources,
 		Runbook:     r.Runbook,
 		Category:    category,
+		RunbookHTML: inlineRunbookHTML(r),
 	}
 
 	htmlResults = append(htmlResults, htmlResult)
@@ -103,81 +169,119 @@ func AddResultForHTML(r CheckResult, category string) {
 	categoryResults[category] = append(categoryResults[category], htmlResult)
 }
 
+// htmlStatus 체크 결과를 HTML 상태 문자열과 bootstrap 클래스로 변환
+func htmlStatus(r CheckResult) (string, string) {
+	switch {
+	case r.NotApplicable:
+		return "N/A", "secondary"
+	case r.Passed:
+		return "PASS", "success"
+	case r.Manual:
+		return "MANUAL", "warning" // bootstrap 경고 클래스
+	case r.TimedOut:
+		return "TIMEOUT", "danger"
+	}
+	return "FAIL", "danger" // bootstrap 위험 클래스
+}
+
+// inlineRunbookHTML 바이너리에 포함된 런북에서 보고서용 섹션을 HTML로 변환
+// 런북 포함 옵션이 꺼져 있거나, 통과/N/A 체크이거나, 런북을 찾지 못하면 빈 값
+func inlineRunbookHTML(r CheckResult) template.HTML {
+	if !InlineRunbook || r.Passed || r.NotApplicable {
+		return ""
+	}
+	markdown, err := runbook.LoadURL(r.Runbook)
+	if err != nil {
+		return ""
+	}
+	return template.HTML(runbook.RenderHTML(runbook.Excerpt(markdown, runbook.ReportSections)))
+}
+
 // SaveHTMLReport HTML 보고서 저장
 func SaveHTMLReport() (string, error) {
 	// 파일 생성
 	now := time.Now()
-	filename := "output/" + "eks-checklist-report-" + now.Format("20060102-150405") + ".html"
+	filename, err := reportPath(now, ".html")
+	if err != nil {
+		return "", err
+	}
 	file, err := os.Create(filename)
 	if err != nil {
 		return "", fmt.Errorf("파일 생성 오류: %v", err)
 	}
 	defer file.Close()
 
-	// 템플릿 로드 - 외부 파일에서 템플릿 파싱
+	if err := WriteHTMLReport(file, now); err != nil {
+		return "", err
+	}
+
+	return filename, nil
+}
+
+// WriteHTMLReport 지금까지 추가된 결과로 HTML 보고서를 생성
+func WriteHTMLReport(w io.Writer, now time.Time) error {
+	// 템플릿 로드 - 사용자 지정 템플릿 또는 바이너리에 포함된 기본 템플릿
 	tmpl, err := loadTemplate()
 	if err != nil {
-		return "", fmt.Errorf("템플릿 로딩 오류: %v", err)
+		return fmt.Errorf("템플릿 로딩 오류: %v", err)
+	}
+
+	summary := SummaryData{
+		PassCount:          PassedCount,
+		FailCount:          FailedCount,
+		ManualCount:        ManualCount,
+		NotApplicableCount: NotApplicableCount,
+		TimedOutCount:      TimedOutCount,
+		Total:              PassedCount + FailedCount + ManualCount,
 	}
 
 	// 템플릿 데이터 설정
 	data := HTMLTemplateData{
-		Title:   "EKS 체크리스트 결과 보고서",
-		Date:    now.Format("2006-01-02 15:04:05"),
-		Results: htmlResults,
-		Summary: SummaryData{
-			PassCount:   PassedCount,
-			FailCount:   FailedCount,
-			ManualCount: ManualCount,
-			Total:       PassedCount + FailedCount + ManualCount,
-		},
+		Title:         "EKS 체크리스트 결과 보고서",
+		Date:          now.Format("2006-01-02 15:04:05"),
+		Results:       htmlResults,
+		Summary:       summary,
 		Categories:    categoryResults,
 		HasCategory:   len(categoryResults) > 0,
 		CategoryOrder: categoryOrder,
 		SortByStatus:  SortByStatus,
+		ResultData: ReportData{
+			Title:   "EKS 체크리스트 결과 보고서",
+			Date:    now.Format(time.RFC3339),
+			Summary: summary,
+			Results: htmlResults,
+		},
+		ResourcePreviewLimit: ResourcePreviewLimit,
 	}
 
-	// 파일에 템플릿 실행 결과 저장
-	err = tmpl.Execute(file, data)
-	if err != nil {
-		return "", fmt.Errorf("템플릿 실행 오류: %v", err)
+	// 템플릿 실행 결과 저장
+	if err := tmpl.Execute(w, data); err != nil {
+		return fmt.Errorf("템플릿 실행 오류: %v", err)
 	}
 
-	return filename, nil
+	return nil
 }
 
-// loadTemplate 템플릿 파일 로드 함수
+// ValidateTemplate 보고서 템플릿을 미리 읽어 오류를 확인 (점검 실행 전 --template 검증용)
+func ValidateTemplate() error {
+	_, err := loadTemplate()
+	return err
+}
+
+// loadTemplate 템플릿 로드 함수
+// --template으로 지정한 파일이 있으면 사용하고, 없으면 바이너리에 포함된 기본 템플릿 사용
 func loadTemplate() (*template.Template, error) {
-	// 템플릿 파일 경로
-	templatePath := "templates/report.html"
-
-	// 템플릿 파일 존재 여부 확인
-	if _, err := os.Stat(templatePath); err == nil {
-		// 템플릿 파일이 존재하면 로드
-		return template.ParseFiles(templatePath)
-	} else if os.IsNotExist(err) {
-		// 템플릿 파일이 없는 경우 프로젝트 루트 기준으로 다시 시도
-		rootTemplatePath := filepath.Join("/workspaces/eks-checklist", templatePath)
-		if _, err := os.Stat(rootTemplatePath); err == nil {
-			return template.ParseFiles(rootTemplatePath)
-		}
+	tmpl := template.New("report").Funcs(templateFuncs)
 
-		// 현재 실행 경로 기준으로 시도
-		execPath, err := os.Executable()
-		if err == nil {
-			execDir := filepath.Dir(execPath)
-			execTemplatePath := filepath.Join(execDir, templatePath)
-			if _, err := os.Stat(execTemplatePath); err == nil {
-				return template.ParseFiles(execTemplatePath)
-			}
-		}
+	if TemplatePath == "" {
+		return tmpl.Parse(templates.Report)
+	}
 
-		// 다양한 경로를 시도해도 템플릿 파일을 찾지 못한 경우 에러 반환
-		return nil, fmt.Errorf("HTML 템플릿 파일을 찾을 수 없습니다: %s", templatePath)
-	} else {
-		// 기타 오류
-		return nil, fmt.Errorf("템플릿 파일 확인 중 오류 발생: %v", err)
+	content, err := os.ReadFile(TemplatePath)
+	if err != nil {
+		return nil, fmt.Errorf("HTML 템플릿 파일을 읽을 수 없습니다: %v", err)
 	}
+	return tmpl.Parse(string(content))
 }
 
 // ConvertHTMLToPDF HTML 보고서를 PDF로 변환
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"eks-checklist/cmd/pdfreport"
+	"eks-checklist/cmd/runbook"
+)
+
+// PDF 생성 방식
+const (
+	// PDFEngineNative 결과 데이터로 PDF를 직접 생성 (외부 프로그램 불필요)
+	PDFEngineNative = "native"
+	// PDFEngineWkhtmltopdf HTML 보고서를 wkhtmltopdf로 변환 (wkhtmltopdf 설치 필요)
+	PDFEngineWkhtmltopdf = "wkhtmltopdf"
+)
+
+// PDFEngines 지원하는 PDF 생성 방식
+var PDFEngines = []string{PDFEngineNative, PDFEngineWkhtmltopdf}
+
+// PDFEngine 현재 PDF 생성 방식
+var PDFEngine = PDFEngineNative
+
+// SetPDFEngine PDF 생성 방식 설정
+func SetPDFEngine(engine string) {
+	PDFEngine = strings.ToLower(engine)
+}
+
+// SavePDFReport 결과 데이터로 PDF 보고서를 직접 생성해 저장
+func SavePDFReport() (string, error) {
+	now := time.Now()
+	filename, err := reportPath(now, ".pdf")
+	if err != nil {
+		return "", err
+	}
+	file, err := os.Create(filename)
+	if err != nil {
+		return "", fmt.Errorf("파일 생성 오류: %v", err)
+	}
+	defer file.Close()
+
+	if err := WritePDFReport(file, now); err != nil {
+		return "", err
+	}
+
+	return filename, nil
+}
+
+// WritePDFReport 지금까지 추가된 결과로 PDF 보고서를 생성 (표지, 요약 차트, 카테고리별 결과 표, Runbook 링크)
+// 런북 포함 옵션이 켜져 있으면 통과하지 못한 체크 아래에 런북 내용도 함께 출력
+func WritePDFReport(w io.Writer, now time.Time) error {
+	report := pdfreport.Report{
+		Title: "EKS 체크리스트 결과 보고서",
+		Date:  now.Format("2006-01-02 15:04:05"),
+		Summary: pdfreport.Summary{
+			Pass:          PassedCount,
+			Fail:          FailedCount,
+			Manual:        ManualCount,
+			NotApplicable: NotApplicableCount,
+			TimedOut:      TimedOutCount,
+		},
+	}
+	for _, r := range htmlResults {
+		report.Results = append(report.Results, pdfreport.Result{
+			CheckName:       r.CheckName,
+			Status:          r.Status,
+			Category:        r.Category,
+			FailureMsg:      r.FailureMsg,
+			Resources:       r.Resources,
+			Runbook:         r.Runbook,
+			RunbookSections: pdfRunbookSections(r),
+		})
+	}
+
+	if err := pdfreport.Render(w, report); err != nil {
+		return fmt.Errorf("PDF 생성 오류: %v", err)
+	}
+	return nil
+}
+
+// pdfRunbookSections 바이너리에 포함된 런북에서 보고서용 섹션을 PDF에 넣을 텍스트로 변환
+// HTML 보고서(inlineRunbookHTML)와 같이 런북 포함 옵션이 꺼져 있거나, 통과/N/A 체크이거나, 런북을 찾지 못하면 빈 값
+func pdfRunbookSections(r CheckResultHTML) []pdfreport.RunbookSection {
+	if !InlineRunbook || r.Status == "PASS" || r.Status == "N/A" {
+		return nil
+	}
+	markdown, err := runbook.LoadURL(r.Runbook)
+	if err != nil {
+		return nil
+	}
+	_, sections := runbook.Parse(runbook.Excerpt(markdown, runbook.ReportSections))
+
+	var result []pdfreport.RunbookSection
+	for _, s := range sections {
+		if s.Title == "" {
+			continue
+		}
+		// 목록 기호는 PDF의 리소스 목록과 같이 -로 표시
+		body := strings.ReplaceAll(runbook.RenderText(s.Body, false), "• ", "- ")
+		result = append(result, pdfreport.RunbookSection{Title: s.Title, Body: strings.TrimSpace(body)})
+	}
+	return result
+}
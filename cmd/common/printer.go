@@ -13,9 +13,13 @@ const (
 )
 
 var (
-	PassedCount     int
-	FailedCount     int
-	ManualCount     int
+	PassedCount int
+	FailedCount int
+	ManualCount int
+	// NotApplicableCount 적용 불가(N/A)로 처리된 체크 수
+	NotApplicableCount int
+	// TimedOutCount 시간 초과 또는 취소로 완료하지 못한 체크 수 (FailedCount에 포함)
+	TimedOutCount   int
 	CurrentCategory string
 
 	// 정렬 모드 관련 변수들
@@ -53,16 +57,25 @@ func PrintCategoryHeader(category string) {
 
 func PrintResult(r CheckResult) {
 	// 필터 기준에 따라 이 결과를 출력할지 확인
-	if !ShouldPrintResult(r.Passed, r.Manual) {
+	if r.NotApplicable {
+		if !ShouldPrintNotApplicable() {
+			return
+		}
+	} else if !ShouldPrintResult(r.Passed, r.Manual) {
 		return // 이 결과는 출력하지 않음
 	}
 
-	if r.Passed {
+	if r.NotApplicable {
+		NotApplicableCount++
+	} else if r.Passed {
 		PassedCount++
 	} else if r.Manual {
 		ManualCount++
 	} else {
 		FailedCount++
+		if r.TimedOut {
+			TimedOutCount++
+		}
 	}
 
 	// HTML 출력을 위한 결과 추가
@@ -92,11 +105,16 @@ func PrintResult(r CheckResult) {
 
 // printSingleResult 단일 결과 출력
 func printSingleResult(r CheckResult) {
-	if r.Passed {
+	if r.NotApplicable {
+		fmt.Printf("➖ N/A | %s\n", r.CheckName)
+		fmt.Printf("  └─ 🔸 이유 : %s\n", r.FailureMsg)
+	} else if r.Passed {
 		fmt.Printf(Green+"✔ PASS | %s\n"+Reset, r.CheckName)
 	} else {
 		if r.Manual {
 			fmt.Printf(Yellow+"⚠ MANUAL | %s\n"+Reset, r.CheckName)
+		} else if r.TimedOut {
+			fmt.Printf(Red+"⏱ TIMEOUT | %s\n"+Reset, r.CheckName)
 		} else {
 			fmt.Printf(Red+"✖ FAIL | %s\n"+Reset, r.CheckName)
 		}
@@ -129,6 +147,17 @@ func PrintSummary() {
 		processSortedHtmlResults()
 	}
 
+	// PDF를 직접 생성하는 경우 HTML 보고서를 거치지 않음
+	if OutputFormat == "pdf" && PDFEngine == PDFEngineNative {
+		pdfFilePath, err := SavePDFReport()
+		if err != nil {
+			fmt.Printf("PDF 보고서 생성 오류: %v\n", err)
+			return
+		}
+		fmt.Printf("PDF 보고서가 %s에 저장되었습니다.\n", pdfFilePath)
+		return
+	}
+
 	if OutputFormat == "html" || OutputFormat == "pdf" {
 		// HTML 보고서 저장
 		htmlFilePath, err := SaveHTMLReport()
@@ -142,7 +171,7 @@ func PrintSummary() {
 			return // HTML 보고서 저장 후 종료
 		}
 
-		// PDF 변환이 필요한 경우
+		// PDF 변환이 필요한 경우 (--pdf-engine wkhtmltopdf)
 		if OutputFormat == "pdf" {
 			pdfFilePath, err := ConvertHTMLToPDF(htmlFilePath)
 			if err != nil {
@@ -158,6 +187,12 @@ func PrintSummary() {
 	fmt.Printf(Green+"✔ PASS: %d\n"+Reset, PassedCount)
 	fmt.Printf(Red+"✖ FAIL: %d\n"+Reset, FailedCount)
 	fmt.Printf(Yellow+"⚠ Manual: %d\n"+Reset, ManualCount)
+	if NotApplicableCount > 0 {
+		fmt.Printf("➖ N/A: %d\n", NotApplicableCount)
+	}
+	if TimedOutCount > 0 {
+		fmt.Printf(Red+"⏱ TIMEOUT: %d (FAIL에 포함)\n"+Reset, TimedOutCount)
+	}
 	fmt.Println("===============[End of Summary]=================")
 }
 
@@ -165,6 +200,10 @@ func PrintSummary() {
 func printSortedTextResults() {
 	// 결과를 상태별로 정렬
 	sort.SliceStable(sortedResults, func(i, j int) bool {
+		// N/A는 가장 마지막에 오도록
+		if sortedResults[i].NotApplicable != sortedResults[j].NotApplicable {
+			return sortedResults[j].NotApplicable
+		}
 		// 먼저 Pass가 먼저 오도록
 		if sortedResults[i].Passed && !sortedResults[j].Passed {
 			return true
@@ -205,7 +244,7 @@ func printSortedTextResults() {
 	if hasFailed := countResults(sortedResults, false, false); hasFailed > 0 {
 		fmt.Printf("\n===============[FAIL]===============\n")
 		for _, r := range sortedResults {
-			if !r.Passed && !r.Manual {
+			if !r.Passed && !r.Manual && !r.NotApplicable {
 				printSingleResult(r)
 			}
 		}
@@ -221,10 +260,26 @@ func printSortedTextResults() {
 		}
 	}
 
+	// N/A 섹션 출력
+	if NotApplicableCount > 0 {
+		fmt.Printf("\n===============[N/A]===============\n")
+		for _, r := range sortedResults {
+			if r.NotApplicable {
+				printSingleResult(r)
+			}
+		}
+	}
+
 	fmt.Println("\n===============[Checklist Summary]===============")
 	fmt.Printf(Green+"✔ PASS: %d\n"+Reset, PassedCount)
 	fmt.Printf(Red+"✖ FAIL: %d\n"+Reset, FailedCount)
 	fmt.Printf(Yellow+"⚠ Manual: %d\n"+Reset, ManualCount)
+	if NotApplicableCount > 0 {
+		fmt.Printf("➖ N/A: %d\n", NotApplicableCount)
+	}
+	if TimedOutCount > 0 {
+		fmt.Printf(Red+"⏱ TIMEOUT: %d (FAIL에 포함)\n"+Reset, TimedOutCount)
+	}
 	fmt.Println("===============[End of Summary]=================")
 }
 
@@ -232,7 +287,7 @@ func printSortedTextResults() {
 func countResults(results []CheckResult, passed bool, manual bool) int {
 	count := 0
 	for _, r := range results {
-		if r.Passed == passed && r.Manual == manual {
+		if !r.NotApplicable && r.Passed == passed && r.Manual == manual {
 			count++
 		}
 	}
@@ -243,20 +298,10 @@ func countResults(results []CheckResult, passed bool, manual bool) int {
 func processSortedHtmlResults() {
 	// HTML 출력용으로 모든 결과를 상태별로 변환
 	for _, r := range sortedResults {
-		status := "PASS"
-		statusClass := "success"
-
-		if !r.Passed {
-			if r.Manual {
-				status = "MANUAL"
-				statusClass = "warning"
-			} else {
-				status = "FAIL"
-				statusClass = "danger"
-			}
-		}
+		status, statusClass := htmlStatus(r)
 
 		htmlResult := CheckResultHTML{
+			Index:       len(sortedHtmlResults),
 			CheckName:   r.CheckName,
 			Status:      status,
 			StatusClass: statusClass,
@@ -264,6 +309,7 @@ func processSortedHtmlResults() {
 			Resources:   r.Resources,
 			Runbook:     r.Runbook,
 			Category:    r.Category,
+			RunbookHTML: inlineRunbookHTML(r),
 		}
 
 		sortedHtmlResults = append(sortedHtmlResults, htmlResult)
@@ -283,19 +329,22 @@ func processSortedHtmlResults() {
 	categoryResults["PASS"] = []CheckResultHTML{}
 	categoryResults["FAIL"] = []CheckResultHTML{}
 	categoryResults["MANUAL"] = []CheckResultHTML{}
+	categoryResults["N/A"] = []CheckResultHTML{}
 
 	// categoryOrder 맨 앞에 상태 카테고리 추가
-	categoryOrder = append([]string{"PASS", "FAIL", "MANUAL"}, categoryOrder...)
+	categoryOrder = append([]string{"PASS", "FAIL", "MANUAL", "N/A"}, categoryOrder...)
 
 	// 각 상태별 결과 분류
 	for _, r := range sortedHtmlResults {
 		switch r.Status {
 		case "PASS":
 			categoryResults["PASS"] = append(categoryResults["PASS"], r)
-		case "FAIL":
+		case "FAIL", "TIMEOUT":
 			categoryResults["FAIL"] = append(categoryResults["FAIL"], r)
 		case "MANUAL":
 			categoryResults["MANUAL"] = append(categoryResults["MANUAL"], r)
+		case "N/A":
+			categoryResults["N/A"] = append(categoryResults["N/A"], r)
 		}
 	}
 }
@@ -0,0 +1,183 @@
+package common_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/testutils"
+)
+
+// renderReport 결과 하나로 HTML 보고서를 생성
+func renderReport(t *testing.T, result common.CheckResult) string {
+	t.Helper()
+	common.SetOutputFormat("html")
+	common.InitHTMLOutput()
+	common.SetCurrentCategory("Security")
+	common.AddResultForHTML(result, "Security")
+
+	var buf bytes.Buffer
+	if err := common.WriteHTMLReport(&buf, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// reportData 보고서에 포함된 결과 데이터(JSON)를 읽음
+func reportData(t *testing.T, html string) common.ReportData {
+	t.Helper()
+	const open = `<script type="application/json" id="report-data">`
+	start := strings.Index(html, open)
+	if start < 0 {
+		t.Fatal("report data script not found")
+	}
+	body := html[start+len(open):]
+	body = body[:strings.Index(body, "</script>")]
+
+	var data common.ReportData
+	if err := json.Unmarshal([]byte(body), &data); err != nil {
+		t.Fatalf("invalid report data: %v", err)
+	}
+	return data
+}
+
+func TestHTMLReportResources(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "report_html.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			var resources []string
+			for i := 0; i < tc["resource_count"].(int); i++ {
+				resources = append(resources, fmt.Sprintf("Namespace: default | Pod: pod-%d", i))
+			}
+			html := renderReport(t, common.CheckResult{
+				CheckName:  "[SEC-005] 루트 유저가 아닌 유저로 컨테이너 실행",
+				FailureMsg: "root로 실행되는 컨테이너가 있습니다.",
+				Resources:  resources,
+				Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-005",
+			})
+
+			if got := strings.Count(html, `<div class="resource-item">`); got != tc["expect_preview"].(int) {
+				t.Errorf("Test '%s' failed: expected %d rendered resources, got %d", testName, tc["expect_preview"].(int), got)
+			}
+			more := fmt.Sprintf("외 %d개 더 보기</button>", tc["expect_more"].(int))
+			if hasMore := strings.Contains(html, more); hasMore != (tc["expect_more"].(int) > 0) {
+				t.Errorf("Test '%s' failed: unexpected more button (expected %q)", testName, more)
+			}
+			if open := strings.Contains(html, `<details class="resource-details mb-3" open>`); open != tc["expect_open"].(bool) {
+				t.Errorf("Test '%s' failed: expected open=%v", testName, tc["expect_open"].(bool))
+			}
+
+			// 미리 표시하지 않은 리소스도 결과 데이터에는 모두 포함
+			data := reportData(t, html)
+			if len(data.Results) != 1 || len(data.Results[0].Resources) != len(resources) {
+				t.Errorf("Test '%s' failed: report data does not contain all resources", testName)
+			}
+		})
+	}
+}
+
+func TestHTMLReportEscaping(t *testing.T) {
+	resource := `Pod: </script><script>alert(1)</script>`
+	html := renderReport(t, common.CheckResult{
+		CheckName: "[SEC-005] test",
+		Resources: []string{resource},
+	})
+
+	if strings.Contains(html, "<script>alert(1)") {
+		t.Error("resource was not escaped")
+	}
+	data := reportData(t, html)
+	if got := data.Results[0]; got.Resources[0] != resource || got.Status != "FAIL" || got.Category != "Security" {
+		t.Errorf("unexpected report data: %+v", got)
+	}
+}
+
+// TestHTMLReportSelfContained 기본 보고서는 외부 CSS/JS/폰트 없이 열리고 차트에 N/A와 시간 초과도 포함
+func TestHTMLReportSelfContained(t *testing.T) {
+	html := renderReport(t, common.CheckResult{CheckName: "[SEC-005] test"})
+
+	for _, external := range []string{"<link ", "<script src=", "cdn.jsdelivr.net", "fonts.googleapis.com"} {
+		if strings.Contains(html, external) {
+			t.Errorf("report should not load external resources, found %q", external)
+		}
+	}
+	for _, label := range []string{"'시간 초과'", "'N/A'"} {
+		if !strings.Contains(html, "label: "+label) {
+			t.Errorf("chart data missing %s", label)
+		}
+	}
+}
+
+func TestHTMLReportCustomTemplate(t *testing.T) {
+	defer common.SetTemplatePath("")
+
+	path := filepath.Join(t.TempDir(), "custom.html")
+	if err := os.WriteFile(path, []byte(`<h1>ACME</h1>{{ range .Results }}{{ .CheckName }} {{ len (preview .Resources) }}{{ end }}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	common.SetTemplatePath(path)
+	if err := common.ValidateTemplate(); err != nil {
+		t.Fatal(err)
+	}
+	if html := renderReport(t, common.CheckResult{CheckName: "[REL-001] test", Resources: []string{"a", "b"}}); html != "<h1>ACME</h1>[REL-001] test 2" {
+		t.Errorf("unexpected custom report: %q", html)
+	}
+
+	common.SetTemplatePath(filepath.Join(t.TempDir(), "missing.html"))
+	if err := common.ValidateTemplate(); err == nil {
+		t.Error("expected error for missing template")
+	}
+}
+
+// TestPDFReportInlineRunbook --inline-runbook 사용 시 PDF 보고서에도 통과하지 못한 체크의 런북 내용 포함
+func TestPDFReportInlineRunbook(t *testing.T) {
+	defer common.SetInlineRunbook(false)
+
+	for _, inline := range []bool{true, false} {
+		common.SetInlineRunbook(inline)
+		common.SetOutputFormat("pdf")
+		common.InitHTMLOutput()
+		common.SetCurrentCategory("Security")
+		common.AddResultForHTML(common.CheckResult{
+			CheckName:  "[SEC-005] 루트 유저가 아닌 유저로 컨테이너 실행",
+			FailureMsg: "root로 실행되는 컨테이너가 있습니다.",
+			Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-005",
+		}, "Security")
+		common.AddResultForHTML(common.CheckResult{
+			CheckName: "[SEC-016] Pod Security Standards 적용",
+			Manual:    true,
+			Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-016",
+		}, "Security")
+		common.AddResultForHTML(common.CheckResult{
+			CheckName: "[SEC-001] EKS 클러스터 API 엔드포인트 접근 제어",
+			Passed:    true,
+			Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-001",
+		}, "Security")
+
+		var buf bytes.Buffer
+		if err := common.WritePDFReport(&buf, time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)); err != nil {
+			t.Fatal(err)
+		}
+		content := testutils.PDFContents(t, buf.Bytes())
+
+		// FAIL, MANUAL 체크만 포함하고 PASS 체크는 제외
+		expect := 0
+		if inline {
+			expect = 2
+		}
+		for _, section := range []string{"Meaning", "Impact", "Diagnosis", "Mitigation"} {
+			if got := strings.Count(content, "(Runbook - "+section+") Tj"); got != expect {
+				t.Errorf("inline=%v: expected %d %s sections, got %d", inline, expect, section, got)
+			}
+		}
+	}
+}
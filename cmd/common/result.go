@@ -1,10 +1,16 @@
 package common
 
+import "html/template"
+
 // CheckResult 체크 결과를 저장하는 구조체
 type CheckResult struct {
-	CheckName  string
-	Passed     bool
-	Manual     bool
+	CheckName string
+	Passed    bool
+	Manual    bool
+	// NotApplicable 실행 환경에서 평가할 수 없는 체크 (예: 매니페스트 스캔 시 AWS 체크)
+	NotApplicable bool
+	// TimedOut 시간 초과 또는 취소로 완료하지 못한 체크 (FAIL로 집계하되 별도 표시)
+	TimedOut   bool
 	FailureMsg string
 	Resources  []string
 	Runbook    string
@@ -12,12 +18,17 @@ type CheckResult struct {
 }
 
 // CheckResultHTML HTML 출력을 위한 체크 결과 구조체
+// JSON 태그는 보고서에 포함하는 결과 데이터(클라이언트 측 검색/필터용) 형식
 type CheckResultHTML struct {
-	CheckName   string
-	Status      string
-	StatusClass string
-	FailureMsg  string
-	Resources   []string
-	Runbook     string
-	Category    string
+	// Index 보고서 결과 목록(HTMLTemplateData.Results)에서의 위치
+	Index       int      `json:"index"`
+	CheckName   string   `json:"checkName"`
+	Status      string   `json:"status"`
+	StatusClass string   `json:"-"`
+	FailureMsg  string   `json:"failureMsg"`
+	Resources   []string `json:"resources"`
+	Runbook     string   `json:"runbook"`
+	Category    string   `json:"category"`
+	// RunbookHTML 보고서에 포함한 런북 내용 (--inline-runbook 사용 시 통과하지 못한 체크만)
+	RunbookHTML template.HTML `json:"-"`
 }
@@ -6,13 +6,14 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // GetKubecost checks if Kubecost is deployed in the cluster.
-func GetKubecost(client kubernetes.Interface) common.CheckResult {
+func GetKubecost(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[COST-001] EKS용 Kubecost 설치",
 		Manual:    false,
@@ -20,7 +21,7 @@ func GetKubecost(client kubernetes.Interface) common.CheckResult {
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/cost/COST-001",
 	}
 
-	deploys, err := client.AppsV1().Deployments(v1.NamespaceAll).List(context.TODO(), v1.ListOptions{})
+	deploys, err := kube.ListAll(ctx, client.AppsV1().Deployments(v1.NamespaceAll).List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -86,7 +86,7 @@ func TestGetKubecost(t *testing.T) {
 			}
 
 			// 함수 실행
-			result := cost.GetKubecost(client)
+			result := cost.GetKubecost(context.Background(), client)
 
 			// 결과 검증: result.Passed가 expect_pass와 동일해야 함.
 			if result.Passed != expectPass {
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"eks-checklist/cmd/catalog"
+	"eks-checklist/cmd/checks"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	docsDir    string
+	docsMkdocs string
+	docsCheck  bool
+)
+
+var docsCmd = &cobra.Command{
+	Use:   "docs",
+	Short: "문서 사이트(mkdocs) 관리",
+}
+
+var docsGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "등록된 체크 목록으로 Runbook 인덱스 문서와 mkdocs.yml nav 생성",
+	Long: `docs/runbook 아래의 인덱스 문서(index.md)와 mkdocs.yml의 Runbook 내비게이션을
+등록된 체크 목록과 같게 다시 생성합니다. 체크를 추가하거나 이름을 바꾼 뒤 실행합니다.
+
+--check를 지정하면 파일을 수정하지 않고 최신 상태인지만 확인하며, 다르면 종료 코드 1로 종료합니다 (CI용).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		entries := catalog.Entries(checks.All)
+
+		files := make(map[string][]byte)
+		for rel, content := range catalog.RunbookIndexes(entries) {
+			files[filepath.Join(docsDir, filepath.FromSlash(rel))] = content
+		}
+		mkdocs, err := os.ReadFile(docsMkdocs)
+		if err != nil {
+			return err
+		}
+		if files[docsMkdocs], err = catalog.UpdateNav(mkdocs, entries); err != nil {
+			return err
+		}
+
+		var missing []string
+		for _, e := range entries {
+			if _, err := os.Stat(filepath.Join(docsDir, filepath.FromSlash(catalog.RunbookPath(e)))); err != nil {
+				missing = append(missing, catalog.RunbookPath(e))
+			}
+		}
+
+		paths := make([]string, 0, len(files))
+		for path := range files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		var outdated []string
+		for _, path := range paths {
+			current, err := os.ReadFile(path)
+			if err == nil && bytes.Equal(current, files[path]) {
+				continue
+			}
+			outdated = append(outdated, path)
+			if docsCheck {
+				continue
+			}
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			if err := os.WriteFile(path, files[path], 0644); err != nil {
+				return err
+			}
+			fmt.Printf("생성: %s\n", path)
+		}
+
+		for _, path := range missing {
+			fmt.Printf("⚠ Runbook 문서 없음: %s\n", filepath.Join(docsDir, filepath.FromSlash(path)))
+		}
+		if docsCheck && (len(outdated) > 0 || len(missing) > 0) {
+			return fmt.Errorf("문서가 등록된 체크 목록과 다릅니다 (갱신 필요: %s). 'eks-checklist docs generate'를 실행하세요", strings.Join(outdated, ", "))
+		}
+		if len(outdated) == 0 {
+			fmt.Println("문서가 최신 상태입니다.")
+		}
+		return nil
+	},
+}
+
+func init() {
+	docsGenerateCmd.Flags().StringVar(&docsDir, "docs-dir", "docs", "mkdocs 문서 디렉터리")
+	docsGenerateCmd.Flags().StringVar(&docsMkdocs, "mkdocs", "mkdocs.yml", "mkdocs 설정 파일 경로")
+	docsGenerateCmd.Flags().BoolVar(&docsCheck, "check", false, "파일을 수정하지 않고 최신 상태인지만 확인")
+
+	docsCmd.AddCommand(docsGenerateCmd)
+	rootCmd.AddCommand(docsCmd)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
@@ -12,17 +13,17 @@ type EksCluster struct {
 	Cluster *types.Cluster
 }
 
-func Describe(clusterName string, cfg aws.Config) EksCluster {
+func Describe(ctx context.Context, clusterName string, cfg aws.Config) (EksCluster, error) {
 	eksClient := eks.NewFromConfig(cfg)
-	output, err := eksClient.DescribeCluster(context.TODO(), &eks.DescribeClusterInput{
+	output, err := eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{
 		Name: &clusterName,
 	})
 
 	if err != nil {
-		panic(err.Error())
+		return EksCluster{}, fmt.Errorf("EKS 클러스터 '%s' 조회 실패: %w", clusterName, err)
 	}
 
 	eksCluster := EksCluster{Cluster: output.Cluster}
 
-	return eksCluster
+	return eksCluster, nil
 }
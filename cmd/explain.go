@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"eks-checklist/cmd/runbook"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	explainSections []string
+	explainRaw      bool
+)
+
+var explainCmd = &cobra.Command{
+	Use:   "explain CHECK_ID...",
+	Short: "체크의 런북을 터미널에 출력 (바이너리에 포함된 문서 사용, 인터넷 연결 불필요)",
+	Long: `체크 ID에 해당하는 런북(Meaning, Impact, Diagnosis, Mitigation)을 출력합니다.
+
+  eks-checklist explain SEC-005
+  eks-checklist explain sec-005 --section mitigation
+  eks-checklist explain REL-001 --raw > REL-001.md
+
+NO_COLOR 환경 변수가 설정되어 있으면 색상 없이 출력합니다.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		color := os.Getenv("NO_COLOR") == ""
+
+		for i, id := range args {
+			_, markdown, err := runbook.Find(id)
+			if err != nil {
+				return err
+			}
+
+			if len(explainSections) > 0 {
+				excerpt := runbook.Excerpt(markdown, explainSections)
+				if excerpt == "" {
+					return fmt.Errorf("%s 런북에 '%s' 섹션이 없습니다", strings.ToUpper(id), strings.Join(explainSections, ", "))
+				}
+				title, _ := runbook.Parse(markdown)
+				markdown = "# " + title + "\n\n" + excerpt
+			}
+
+			if i > 0 {
+				fmt.Println()
+			}
+			if explainRaw {
+				fmt.Print(strings.TrimRight(markdown, "\r\n") + "\n")
+			} else {
+				fmt.Print(runbook.RenderText(markdown, color))
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	explainCmd.Flags().StringSliceVar(&explainSections, "section", nil, "출력할 섹션 (meaning, impact, diagnosis, mitigation). 비어 있으면 전체")
+	explainCmd.Flags().BoolVar(&explainRaw, "raw", false, "터미널 서식 없이 원본 마크다운 출력")
+
+	rootCmd.AddCommand(explainCmd)
+}
@@ -3,13 +3,15 @@ package general
 import (
 	"context"
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
-func CheckImageTag(client kubernetes.Interface) common.CheckResult {
+func CheckImageTag(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[GEN-003] 컨테이너 이미지 태그에 latest 미사용",
 		Manual:    false,
@@ -19,7 +21,7 @@ func CheckImageTag(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/general/GEN-003",
 	}
 
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -27,13 +29,22 @@ func CheckImageTag(client kubernetes.Interface) common.CheckResult {
 	}
 
 	for _, pod := range pods.Items {
-		for _, container := range pod.Spec.Containers {
-			if strings.Contains(container.Image, "latest") {
-				result.Passed = false
-				result.Resources = append(result.Resources, "Namespace: "+pod.Namespace+" | Pod: "+pod.Name+" | Container: "+container.Name+" | Image: "+container.Image)
-			}
+		for _, violation := range LatestImageTagViolations(pod.Spec) {
+			result.Passed = false
+			result.Resources = append(result.Resources, "Namespace: "+pod.Namespace+" | Pod: "+pod.Name+" | "+violation)
 		}
 	}
 
 	return result
 }
+
+// LatestImageTagViolations PodSpec에서 latest 태그를 사용하는 컨테이너를 찾아 반환
+func LatestImageTagViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	for _, container := range spec.Containers {
+		if strings.Contains(container.Image, "latest") {
+			violations = append(violations, "Container: "+container.Name+" | Image: "+container.Image)
+		}
+	}
+	return violations
+}
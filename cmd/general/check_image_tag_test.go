@@ -51,7 +51,7 @@ func TestCheckImageTag(t *testing.T) {
 			}
 
 			// CheckImageTag 함수 실행 및 결과 검증
-			result := general.CheckImageTag(client)
+			result := general.CheckImageTag(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"eks-checklist/cmd/artifacts"
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/iac"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/spf13/cobra"
+)
+
+var iacCmd = &cobra.Command{
+	Use:   "iac FILE...",
+	Short: "Terraform plan 또는 eksctl ClusterConfig를 대상으로 클러스터 수준 체크 실행",
+	Long: `적용 전 IaC 정의로 클러스터 수준 체크(SEC-001, SEC-007, SEC-011, SEC-012, SCL-007, REL-011)를 실행합니다.
+
+지원 형식:
+  - terraform show -json 결과 (aws_eks_cluster, aws_eks_node_group 리소스, 하위 모듈 포함)
+      terraform plan -out plan.out && terraform show -json plan.out > plan.json
+      eks-checklist iac plan.json
+  - eksctl ClusterConfig YAML
+      eks-checklist iac cluster.yaml
+
+FILE에 '-'를 지정하면 표준 입력에서 읽습니다.`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configureOutput()
+
+		var sources []iac.Source
+		for _, path := range args {
+			loaded, err := iac.LoadFile(path, os.Stdin)
+			if err != nil {
+				return err
+			}
+			sources = append(sources, loaded...)
+		}
+
+		// 정의 파일에 클러스터가 하나면 그 클러스터 이름으로 출력 디렉터리 이름을 만듦
+		name, clusterARN := "iac", ""
+		if len(sources) == 1 {
+			name, clusterARN = aws.ToString(sources[0].Cluster.Name), aws.ToString(sources[0].Cluster.Arn)
+		}
+		layout := newRunLayout(artifacts.ClusterVars(name, clusterARN, "", time.Now()))
+
+		var runResults []artifacts.Result
+		for _, source := range sources {
+			fmt.Printf("Running checks on %s (%s: %s)\n", aws.ToString(source.Cluster.Name), source.Format, source.Address)
+
+			results := iac.Evaluate(source)
+			for _, category := range checks.Categories {
+				header := false
+				for _, r := range results {
+					if r.Category != category {
+						continue
+					}
+					if !header {
+						common.PrintCategoryHeader(category)
+						header = true
+					}
+					common.PrintResult(r.Result)
+					runResults = append(runResults, artifactResult("", r.Category, r.Result))
+				}
+			}
+		}
+
+		common.PrintSummary()
+		finishRun(cmd, layout, runResults)
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(iacCmd)
+}
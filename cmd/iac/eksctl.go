@@ -0,0 +1,269 @@
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// eksctl이 VPC를 생성하는 경우 서브넷 ID가 확정되지 않으므로 사용하는 표시용 이름
+const (
+	eksctlPublicSubnets  = "eksctl 생성 퍼블릭 서브넷"
+	eksctlPrivateSubnets = "eksctl 생성 프라이빗 서브넷"
+)
+
+// eksctl ClusterConfig (eksctl.io/v1alpha5) 중 체크에 필요한 필드
+type eksctlConfig struct {
+	Metadata struct {
+		Name    string `json:"name"`
+		Region  string `json:"region"`
+		Version string `json:"version"`
+	} `json:"metadata"`
+	VPC *struct {
+		ID      string `json:"id"`
+		Subnets *struct {
+			Private map[string]eksctlSubnet `json:"private"`
+			Public  map[string]eksctlSubnet `json:"public"`
+		} `json:"subnets"`
+		ClusterEndpoints *struct {
+			PublicAccess  *bool `json:"publicAccess"`
+			PrivateAccess *bool `json:"privateAccess"`
+		} `json:"clusterEndpoints"`
+		PublicAccessCIDRs []string `json:"publicAccessCIDRs"`
+	} `json:"vpc"`
+	CloudWatch *struct {
+		ClusterLogging *struct {
+			EnableTypes []string `json:"enableTypes"`
+		} `json:"clusterLogging"`
+	} `json:"cloudWatch"`
+	SecretsEncryption *struct {
+		KeyARN string `json:"keyARN"`
+	} `json:"secretsEncryption"`
+	AccessConfig *struct {
+		AuthenticationMode string `json:"authenticationMode"`
+	} `json:"accessConfig"`
+	ManagedNodeGroups []eksctlNodeGroup `json:"managedNodeGroups"`
+	NodeGroups        []eksctlNodeGroup `json:"nodeGroups"`
+}
+
+type eksctlSubnet struct {
+	ID string `json:"id"`
+}
+
+type eksctlNodeGroup struct {
+	Name              string            `json:"name"`
+	AMIFamily         string            `json:"amiFamily"`
+	InstanceType      string            `json:"instanceType"`
+	InstanceTypes     []string          `json:"instanceTypes"`
+	DesiredCapacity   *int32            `json:"desiredCapacity"`
+	MinSize           *int32            `json:"minSize"`
+	MaxSize           *int32            `json:"maxSize"`
+	PrivateNetworking bool              `json:"privateNetworking"`
+	Subnets           []string          `json:"subnets"`
+	Spot              bool              `json:"spot"`
+	Labels            map[string]string `json:"labels"`
+	SSH               *struct {
+		Allow                  *bool    `json:"allow"`
+		PublicKeyName          string   `json:"publicKeyName"`
+		SourceSecurityGroupIDs []string `json:"sourceSecurityGroupIds"`
+	} `json:"ssh"`
+	InstancesDistribution *struct {
+		InstanceTypes []string `json:"instanceTypes"`
+	} `json:"instancesDistribution"`
+}
+
+// parseEksctl eksctl ClusterConfig를 Source로 변환 (eksctl 기본값 반영)
+func parseEksctl(data []byte, path string) (Source, error) {
+	var config eksctlConfig
+	if err := json.Unmarshal(data, &config); err != nil {
+		return Source{}, err
+	}
+	if config.Metadata.Name == "" {
+		return Source{}, fmt.Errorf("metadata.name이 없습니다")
+	}
+
+	cluster := &types.Cluster{
+		Name: aws.String(config.Metadata.Name),
+		// eksctl 기본값: 퍼블릭 엔드포인트만 활성화
+		ResourcesVpcConfig: &types.VpcConfigResponse{
+			EndpointPublicAccess: true,
+			PublicAccessCidrs:    []string{"0.0.0.0/0"},
+		},
+		Logging: &types.Logging{},
+	}
+	if config.Metadata.Version != "" {
+		cluster.Version = aws.String(config.Metadata.Version)
+	}
+
+	publicSubnets := make(map[string]bool)
+	var publicIDs, privateIDs []string
+	subnetsByName := make(map[string]string)
+
+	if vpc := config.VPC; vpc != nil {
+		if vpc.ID != "" {
+			cluster.ResourcesVpcConfig.VpcId = aws.String(vpc.ID)
+		}
+		if endpoints := vpc.ClusterEndpoints; endpoints != nil {
+			if endpoints.PublicAccess != nil {
+				cluster.ResourcesVpcConfig.EndpointPublicAccess = *endpoints.PublicAccess
+			}
+			if endpoints.PrivateAccess != nil {
+				cluster.ResourcesVpcConfig.EndpointPrivateAccess = *endpoints.PrivateAccess
+			}
+		}
+		if len(vpc.PublicAccessCIDRs) > 0 {
+			cluster.ResourcesVpcConfig.PublicAccessCidrs = vpc.PublicAccessCIDRs
+		}
+		if vpc.Subnets != nil {
+			publicIDs = subnetIDs(vpc.Subnets.Public, subnetsByName)
+			privateIDs = subnetIDs(vpc.Subnets.Private, subnetsByName)
+		}
+	}
+	for _, id := range publicIDs {
+		publicSubnets[id] = true
+	}
+	for _, id := range privateIDs {
+		publicSubnets[id] = false
+	}
+	cluster.ResourcesVpcConfig.SubnetIds = append(append([]string{}, publicIDs...), privateIDs...)
+
+	if config.CloudWatch != nil && config.CloudWatch.ClusterLogging != nil && len(config.CloudWatch.ClusterLogging.EnableTypes) > 0 {
+		setup := types.LogSetup{Enabled: aws.Bool(true)}
+		for _, logType := range config.CloudWatch.ClusterLogging.EnableTypes {
+			if logType == "*" || logType == "all" {
+				setup.Types = []types.LogType{
+					types.LogTypeApi, types.LogTypeAudit, types.LogTypeAuthenticator,
+					types.LogTypeControllerManager, types.LogTypeScheduler,
+				}
+				break
+			}
+			setup.Types = append(setup.Types, types.LogType(logType))
+		}
+		cluster.Logging.ClusterLogging = []types.LogSetup{setup}
+	}
+
+	if config.SecretsEncryption != nil && config.SecretsEncryption.KeyARN != "" {
+		cluster.EncryptionConfig = []types.EncryptionConfig{{
+			Resources: []string{"secrets"},
+			Provider:  &types.Provider{KeyArn: aws.String(config.SecretsEncryption.KeyARN)},
+		}}
+	}
+
+	if config.AccessConfig != nil && config.AccessConfig.AuthenticationMode != "" {
+		cluster.AccessConfig = &types.AccessConfigResponse{
+			AuthenticationMode: types.AuthenticationMode(config.AccessConfig.AuthenticationMode),
+		}
+	}
+
+	source := Source{
+		Format:        FormatEksctl,
+		Address:       path,
+		Cluster:       cluster,
+		PublicSubnets: publicSubnets,
+	}
+
+	// 서브넷을 지정하지 않은 노드 그룹은 privateNetworking 설정에 따라 퍼블릭/프라이빗 서브넷에 배치됨
+	defaultSubnets := func(private bool) []string {
+		if private {
+			if len(privateIDs) > 0 {
+				return privateIDs
+			}
+			source.PublicSubnets[eksctlPrivateSubnets] = false
+			return []string{eksctlPrivateSubnets}
+		}
+		if len(publicIDs) > 0 {
+			return publicIDs
+		}
+		source.PublicSubnets[eksctlPublicSubnets] = true
+		return []string{eksctlPublicSubnets}
+	}
+
+	for _, ng := range config.ManagedNodeGroups {
+		source.Nodegroups = append(source.Nodegroups, ng.toNodegroup(config.Metadata.Name, subnetsByName, defaultSubnets))
+	}
+	for _, ng := range config.NodeGroups {
+		source.SelfManagedNodegroups = append(source.SelfManagedNodegroups, ng.toNodegroup(config.Metadata.Name, subnetsByName, defaultSubnets))
+	}
+
+	return source, nil
+}
+
+// subnetIDs eksctl 서브넷 맵(가용 영역 또는 이름 -> 서브넷)에서 ID 목록 추출
+func subnetIDs(subnets map[string]eksctlSubnet, byName map[string]string) []string {
+	var keys []string
+	for key := range subnets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var ids []string
+	for _, key := range keys {
+		id := subnets[key].ID
+		if id == "" {
+			id = key
+		}
+		byName[key] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (ng eksctlNodeGroup) toNodegroup(clusterName string, subnetsByName map[string]string, defaultSubnets func(private bool) []string) types.Nodegroup {
+	nodegroup := types.Nodegroup{
+		ClusterName:   aws.String(clusterName),
+		NodegroupName: aws.String(ng.Name),
+		Labels:        ng.Labels,
+	}
+
+	switch {
+	case len(ng.InstanceTypes) > 0:
+		nodegroup.InstanceTypes = ng.InstanceTypes
+	case ng.InstancesDistribution != nil && len(ng.InstancesDistribution.InstanceTypes) > 0:
+		nodegroup.InstanceTypes = ng.InstancesDistribution.InstanceTypes
+	case ng.InstanceType != "":
+		nodegroup.InstanceTypes = []string{ng.InstanceType}
+	default:
+		// eksctl 기본 인스턴스 타입
+		nodegroup.InstanceTypes = []string{"m5.large"}
+	}
+
+	if ng.Spot {
+		nodegroup.CapacityType = types.CapacityTypesSpot
+	}
+
+	// eksctl 기본값: desiredCapacity, minSize, maxSize 모두 2
+	scaling := &types.NodegroupScalingConfig{DesiredSize: ng.DesiredCapacity, MinSize: ng.MinSize, MaxSize: ng.MaxSize}
+	if scaling.DesiredSize == nil {
+		scaling.DesiredSize = aws.Int32(2)
+	}
+	if scaling.MinSize == nil {
+		scaling.MinSize = scaling.DesiredSize
+	}
+	if scaling.MaxSize == nil {
+		scaling.MaxSize = scaling.DesiredSize
+	}
+	nodegroup.ScalingConfig = scaling
+
+	if len(ng.Subnets) > 0 {
+		for _, subnet := range ng.Subnets {
+			if id, ok := subnetsByName[subnet]; ok {
+				subnet = id
+			}
+			nodegroup.Subnets = append(nodegroup.Subnets, subnet)
+		}
+	} else {
+		nodegroup.Subnets = defaultSubnets(ng.PrivateNetworking)
+	}
+
+	if ng.SSH != nil && ng.SSH.Allow != nil && *ng.SSH.Allow {
+		nodegroup.RemoteAccess = &types.RemoteAccessConfig{SourceSecurityGroups: ng.SSH.SourceSecurityGroupIDs}
+		if ng.SSH.PublicKeyName != "" {
+			nodegroup.RemoteAccess.Ec2SshKey = aws.String(ng.SSH.PublicKeyName)
+		}
+	}
+
+	return nodegroup
+}
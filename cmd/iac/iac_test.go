@@ -0,0 +1,66 @@
+package iac_test
+
+import (
+	"regexp"
+	"testing"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/iac"
+	"eks-checklist/cmd/testutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+var checkIDPattern = regexp.MustCompile(`^\[([A-Z]+-\d+)\]`)
+
+func status(r common.CheckResult) string {
+	switch {
+	case r.Passed:
+		return "pass"
+	case r.Manual:
+		return "manual"
+	}
+	return "fail"
+}
+
+func TestParseAndEvaluate(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "iac_parse.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		input := tc["input"].(string)
+		expectCluster := tc["expect_cluster"].(string)
+		expectNodegroups := tc["expect_nodegroups"].(int)
+		expectResults := tc["expect_results"].(map[string]interface{})
+
+		t.Run(testName, func(t *testing.T) {
+			sources, err := iac.Parse([]byte(input), testName)
+			if err != nil {
+				t.Fatalf("IaC 해석 실패: %v", err)
+			}
+			if len(sources) != 1 {
+				t.Fatalf("Test '%s' failed: expected 1 cluster, got %d", testName, len(sources))
+			}
+
+			source := sources[0]
+			if aws.ToString(source.Cluster.Name) != expectCluster {
+				t.Errorf("Test '%s' failed: expected cluster %s, got %s", testName, expectCluster, aws.ToString(source.Cluster.Name))
+			}
+			if len(source.Nodegroups) != expectNodegroups {
+				t.Errorf("Test '%s' failed: expected %d managed nodegroups, got %d", testName, expectNodegroups, len(source.Nodegroups))
+			}
+
+			for _, r := range iac.Evaluate(source) {
+				id := checkIDPattern.FindStringSubmatch(r.Result.CheckName)[1]
+				expect, ok := expectResults[id]
+				if !ok {
+					t.Errorf("Test '%s' failed: unexpected check %s", testName, id)
+					continue
+				}
+				if got := status(r.Result); got != expect.(string) {
+					t.Errorf("Test '%s' failed: %s expected %s, got %s (%s %v)", testName, id, expect, got, r.Result.FailureMsg, r.Result.Resources)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package iac
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/reliability"
+	"eks-checklist/cmd/scalability"
+	"eks-checklist/cmd/security"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// 지원하는 IaC 형식
+const (
+	FormatTerraform = "terraform"
+	FormatEksctl    = "eksctl"
+)
+
+// Source IaC에서 추출한 클러스터 구성 (체크가 사용하는 EKS API 응답 형태로 변환)
+type Source struct {
+	Format string
+	// Address 원본 위치 (Terraform 리소스 주소 또는 eksctl 파일 경로)
+	Address string
+	Cluster *types.Cluster
+	// Nodegroups 관리형 노드 그룹
+	Nodegroups []types.Nodegroup
+	// SelfManagedNodegroups 자체 관리형 노드 그룹 (eksctl nodeGroups)
+	SelfManagedNodegroups []types.Nodegroup
+	// PublicSubnets 구성 정보로 공개 여부를 확인할 수 있는 서브넷 (서브넷 ID -> 퍼블릭 여부)
+	PublicSubnets map[string]bool
+}
+
+// Result 카테고리 정보가 포함된 체크 결과
+type Result struct {
+	Category string
+	Result   common.CheckResult
+}
+
+// LoadFile 파일 경로의 IaC 정의를 읽음. "-"는 표준 입력을 의미
+func LoadFile(path string, stdin io.Reader) ([]Source, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("IaC 파일 읽기 실패 '%s': %v", path, err)
+	}
+
+	sources, err := Parse(data, path)
+	if err != nil {
+		return nil, fmt.Errorf("IaC 파일 해석 실패 '%s': %v", path, err)
+	}
+	return sources, nil
+}
+
+// Parse Terraform plan JSON(terraform show -json) 또는 eksctl ClusterConfig YAML을 해석
+func Parse(data []byte, path string) ([]Source, error) {
+	// terraform show -json 결과는 그대로 사용하고 YAML은 JSON으로 변환
+	jsonData := bytes.TrimSpace(data)
+	if !bytes.HasPrefix(jsonData, []byte("{")) {
+		converted, err := utilyaml.ToJSON(data)
+		if err != nil {
+			return nil, err
+		}
+		jsonData = converted
+	}
+
+	var probe struct {
+		Kind          string          `json:"kind"`
+		FormatVersion string          `json:"format_version"`
+		PlannedValues json.RawMessage `json:"planned_values"`
+		Values        json.RawMessage `json:"values"`
+	}
+	if err := json.Unmarshal(jsonData, &probe); err != nil {
+		return nil, fmt.Errorf("지원하지 않는 형식입니다: %v", err)
+	}
+
+	switch {
+	case probe.Kind == "ClusterConfig":
+		source, err := parseEksctl(jsonData, path)
+		if err != nil {
+			return nil, err
+		}
+		return []Source{source}, nil
+	case probe.FormatVersion != "" || probe.PlannedValues != nil || probe.Values != nil:
+		return parseTerraform(jsonData)
+	}
+	return nil, fmt.Errorf("terraform show -json 결과 또는 eksctl ClusterConfig가 아닙니다")
+}
+
+// Evaluate 클러스터 구성만으로 평가할 수 있는 체크 실행
+func Evaluate(source Source) []Result {
+	eksCluster := security.EksCluster{Cluster: source.Cluster}
+	allNodegroups := append(append([]types.Nodegroup{}, source.Nodegroups...), source.SelfManagedNodegroups...)
+
+	return []Result{
+		// EKS 클러스터 API 엔드포인트 접근 제어
+		{Category: checks.CategorySecurity, Result: security.CheckEndpointPublicAccess(eksCluster)},
+		// Audit 로그 활성화
+		{Category: checks.CategorySecurity, Result: security.CheckAuditLoggingEnabled(&eksCluster)},
+		// Secret 객체 암호화 (EncryptionConfig)
+		{Category: checks.CategorySecurity, Result: security.CheckClusterSecretEncryption(eksCluster)},
+		// 데이터 플레인 사설망
+		{Category: checks.CategorySecurity, Result: security.CheckNodegroupSubnetsPrivate(allNodegroups, source.PublicSubnets)},
+		// 다양한 인스턴스 타입 사용
+		{Category: checks.CategoryScalability, Result: scalability.CheckNodegroupInstanceTypes(allNodegroups)},
+		// 오토스케일링 그룹 기반 관리형 노드 그룹 생성
+		{Category: checks.CategoryStability, Result: reliability.CheckNodegroupScalingConfig(source.Nodegroups)},
+	}
+}
@@ -0,0 +1,419 @@
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"eks-checklist/cmd/security"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// tfPlan terraform show -json 출력 (plan 또는 state)
+type tfPlan struct {
+	PlannedValues *tfValues `json:"planned_values"`
+	Values        *tfValues `json:"values"`
+	PriorState    *struct {
+		Values *tfValues `json:"values"`
+	} `json:"prior_state"`
+}
+
+type tfValues struct {
+	RootModule tfModule `json:"root_module"`
+}
+
+type tfModule struct {
+	Address      string       `json:"address"`
+	Resources    []tfResource `json:"resources"`
+	ChildModules []tfModule   `json:"child_modules"`
+}
+
+type tfResource struct {
+	Address string          `json:"address"`
+	Mode    string          `json:"mode"`
+	Type    string          `json:"type"`
+	Values  json.RawMessage `json:"values"`
+
+	module string
+}
+
+// aws_eks_cluster 리소스 속성 (plan 시점에 확정되지 않은 값은 출력에서 생략됨)
+type tfEksCluster struct {
+	Name                   string   `json:"name"`
+	Version                string   `json:"version"`
+	EnabledClusterLogTypes []string `json:"enabled_cluster_log_types"`
+	VpcConfig              []struct {
+		EndpointPrivateAccess *bool    `json:"endpoint_private_access"`
+		EndpointPublicAccess  *bool    `json:"endpoint_public_access"`
+		PublicAccessCidrs     []string `json:"public_access_cidrs"`
+		SubnetIds             []string `json:"subnet_ids"`
+		SecurityGroupIds      []string `json:"security_group_ids"`
+		VpcId                 string   `json:"vpc_id"`
+	} `json:"vpc_config"`
+	EncryptionConfig []struct {
+		Resources []string `json:"resources"`
+		Provider  []struct {
+			KeyArn string `json:"key_arn"`
+		} `json:"provider"`
+	} `json:"encryption_config"`
+	AccessConfig []struct {
+		AuthenticationMode string `json:"authentication_mode"`
+	} `json:"access_config"`
+}
+
+// aws_eks_node_group 리소스 속성
+type tfNodeGroup struct {
+	ClusterName   string   `json:"cluster_name"`
+	NodeGroupName string   `json:"node_group_name"`
+	SubnetIds     []string `json:"subnet_ids"`
+	InstanceTypes []string `json:"instance_types"`
+	CapacityType  string   `json:"capacity_type"`
+	AmiType       string   `json:"ami_type"`
+	ScalingConfig []struct {
+		DesiredSize *int32 `json:"desired_size"`
+		MaxSize     *int32 `json:"max_size"`
+		MinSize     *int32 `json:"min_size"`
+	} `json:"scaling_config"`
+	RemoteAccess []struct {
+		Ec2SshKey              string   `json:"ec2_ssh_key"`
+		SourceSecurityGroupIds []string `json:"source_security_group_ids"`
+	} `json:"remote_access"`
+	LaunchTemplate []struct {
+		Id      string `json:"id"`
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"launch_template"`
+	Labels map[string]string `json:"labels"`
+}
+
+type tfRouteTable struct {
+	Id    string `json:"id"`
+	Route []struct {
+		CidrBlock string `json:"cidr_block"`
+		GatewayId string `json:"gateway_id"`
+	} `json:"route"`
+}
+
+type tfRoute struct {
+	RouteTableId         string `json:"route_table_id"`
+	DestinationCidrBlock string `json:"destination_cidr_block"`
+	GatewayId            string `json:"gateway_id"`
+}
+
+type tfRouteTableAssociation struct {
+	SubnetId     string `json:"subnet_id"`
+	RouteTableId string `json:"route_table_id"`
+}
+
+type tfSubnet struct {
+	Id                  string `json:"id"`
+	MapPublicIpOnLaunch bool   `json:"map_public_ip_on_launch"`
+}
+
+// parseTerraform aws_eks_cluster/aws_eks_node_group 리소스를 클러스터 단위 Source로 변환
+func parseTerraform(data []byte) ([]Source, error) {
+	var plan tfPlan
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, err
+	}
+
+	var resources []tfResource
+	switch {
+	case plan.PlannedValues != nil:
+		resources = collectResources(plan.PlannedValues.RootModule, resources)
+	case plan.Values != nil:
+		resources = collectResources(plan.Values.RootModule, resources)
+	}
+	// 데이터 소스와 기존 리소스는 prior_state에서 보완
+	if plan.PriorState != nil && plan.PriorState.Values != nil {
+		resources = collectResources(plan.PriorState.Values.RootModule, resources)
+	}
+
+	var sources []Source
+	var clusterModules []string
+	var nodegroups []tfResource
+	seen := make(map[string]bool)
+
+	for _, res := range resources {
+		if seen[res.Address] {
+			continue // planned_values 값을 우선 사용
+		}
+		seen[res.Address] = true
+
+		switch {
+		case res.Mode == "managed" && res.Type == "aws_eks_cluster":
+			var c tfEksCluster
+			if err := json.Unmarshal(res.Values, &c); err != nil {
+				return nil, fmt.Errorf("%s 해석 실패: %v", res.Address, err)
+			}
+			sources = append(sources, Source{
+				Format:  FormatTerraform,
+				Address: res.Address,
+				Cluster: c.toCluster(),
+			})
+			clusterModules = append(clusterModules, res.module)
+		case res.Mode == "managed" && res.Type == "aws_eks_node_group":
+			nodegroups = append(nodegroups, res)
+		}
+	}
+
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("aws_eks_cluster 리소스를 찾을 수 없습니다")
+	}
+
+	for _, res := range nodegroups {
+		var ng tfNodeGroup
+		if err := json.Unmarshal(res.Values, &ng); err != nil {
+			return nil, fmt.Errorf("%s 해석 실패: %v", res.Address, err)
+		}
+		i := matchCluster(sources, clusterModules, ng.ClusterName, res.module)
+		if i < 0 {
+			continue
+		}
+		sources[i].Nodegroups = append(sources[i].Nodegroups, ng.toNodegroup(res.Address))
+	}
+
+	publicSubnets, err := terraformPublicSubnets(resources)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sources {
+		sources[i].PublicSubnets = publicSubnets
+	}
+
+	return sources, nil
+}
+
+// collectResources 하위 모듈까지 포함하여 리소스 목록을 평탄화
+func collectResources(module tfModule, resources []tfResource) []tfResource {
+	for _, res := range module.Resources {
+		res.module = module.Address
+		resources = append(resources, res)
+	}
+	for _, child := range module.ChildModules {
+		resources = collectResources(child, resources)
+	}
+	return resources
+}
+
+// matchCluster 노드 그룹이 속한 클러스터 선택
+// cluster_name이 확정되지 않은 경우 같은 모듈(또는 상위 모듈)에 정의된 클러스터를 사용
+func matchCluster(sources []Source, clusterModules []string, clusterName string, module string) int {
+	if clusterName != "" {
+		for i, source := range sources {
+			if aws.ToString(source.Cluster.Name) == clusterName {
+				return i
+			}
+		}
+	}
+	if len(sources) == 1 {
+		return 0
+	}
+
+	best, bestLen := -1, -1
+	for i, clusterModule := range clusterModules {
+		if strings.HasPrefix(module, clusterModule) && len(clusterModule) > bestLen {
+			best, bestLen = i, len(clusterModule)
+		}
+	}
+	return best
+}
+
+func (c tfEksCluster) toCluster() *types.Cluster {
+	cluster := &types.Cluster{
+		Name:               aws.String(c.Name),
+		ResourcesVpcConfig: &types.VpcConfigResponse{},
+	}
+	if c.Version != "" {
+		cluster.Version = aws.String(c.Version)
+	}
+
+	// endpoint_public_access 기본값 true, endpoint_private_access 기본값 false
+	vpc := cluster.ResourcesVpcConfig
+	vpc.EndpointPublicAccess = true
+	vpc.PublicAccessCidrs = []string{"0.0.0.0/0"}
+	if len(c.VpcConfig) > 0 {
+		config := c.VpcConfig[0]
+		if config.EndpointPublicAccess != nil {
+			vpc.EndpointPublicAccess = *config.EndpointPublicAccess
+		}
+		if config.EndpointPrivateAccess != nil {
+			vpc.EndpointPrivateAccess = *config.EndpointPrivateAccess
+		}
+		if len(config.PublicAccessCidrs) > 0 {
+			vpc.PublicAccessCidrs = config.PublicAccessCidrs
+		}
+		vpc.SubnetIds = config.SubnetIds
+		vpc.SecurityGroupIds = config.SecurityGroupIds
+		if config.VpcId != "" {
+			vpc.VpcId = aws.String(config.VpcId)
+		}
+	}
+
+	cluster.Logging = &types.Logging{}
+	if len(c.EnabledClusterLogTypes) > 0 {
+		setup := types.LogSetup{Enabled: aws.Bool(true)}
+		for _, logType := range c.EnabledClusterLogTypes {
+			setup.Types = append(setup.Types, types.LogType(logType))
+		}
+		cluster.Logging.ClusterLogging = append(cluster.Logging.ClusterLogging, setup)
+	}
+
+	for _, config := range c.EncryptionConfig {
+		encryption := types.EncryptionConfig{Resources: config.Resources, Provider: &types.Provider{}}
+		if len(config.Provider) > 0 && config.Provider[0].KeyArn != "" {
+			encryption.Provider.KeyArn = aws.String(config.Provider[0].KeyArn)
+		}
+		cluster.EncryptionConfig = append(cluster.EncryptionConfig, encryption)
+	}
+
+	if len(c.AccessConfig) > 0 && c.AccessConfig[0].AuthenticationMode != "" {
+		cluster.AccessConfig = &types.AccessConfigResponse{
+			AuthenticationMode: types.AuthenticationMode(c.AccessConfig[0].AuthenticationMode),
+		}
+	}
+
+	return cluster
+}
+
+func (ng tfNodeGroup) toNodegroup(address string) types.Nodegroup {
+	name := ng.NodeGroupName
+	if name == "" {
+		// node_group_name_prefix 사용 등으로 이름이 확정되지 않은 경우
+		name = address
+	}
+
+	nodegroup := types.Nodegroup{
+		NodegroupName: aws.String(name),
+		Subnets:       ng.SubnetIds,
+		InstanceTypes: ng.InstanceTypes,
+		Labels:        ng.Labels,
+	}
+	if ng.ClusterName != "" {
+		nodegroup.ClusterName = aws.String(ng.ClusterName)
+	}
+	if ng.CapacityType != "" {
+		nodegroup.CapacityType = types.CapacityTypes(ng.CapacityType)
+	}
+	if ng.AmiType != "" {
+		nodegroup.AmiType = types.AMITypes(ng.AmiType)
+	}
+	if len(ng.ScalingConfig) > 0 {
+		sc := ng.ScalingConfig[0]
+		nodegroup.ScalingConfig = &types.NodegroupScalingConfig{
+			DesiredSize: sc.DesiredSize,
+			MaxSize:     sc.MaxSize,
+			MinSize:     sc.MinSize,
+		}
+	}
+	if len(ng.RemoteAccess) > 0 {
+		ra := ng.RemoteAccess[0]
+		nodegroup.RemoteAccess = &types.RemoteAccessConfig{SourceSecurityGroups: ra.SourceSecurityGroupIds}
+		if ra.Ec2SshKey != "" {
+			nodegroup.RemoteAccess.Ec2SshKey = aws.String(ra.Ec2SshKey)
+		}
+	}
+	if len(ng.LaunchTemplate) > 0 {
+		lt := ng.LaunchTemplate[0]
+		nodegroup.LaunchTemplate = &types.LaunchTemplateSpecification{}
+		if lt.Id != "" {
+			nodegroup.LaunchTemplate.Id = aws.String(lt.Id)
+		}
+		if lt.Name != "" {
+			nodegroup.LaunchTemplate.Name = aws.String(lt.Name)
+		}
+		if lt.Version != "" {
+			nodegroup.LaunchTemplate.Version = aws.String(lt.Version)
+		}
+	}
+	return nodegroup
+}
+
+// terraformPublicSubnets 라우트 테이블 연결 정보와 서브넷 속성으로 서브넷의 퍼블릭 여부 판단
+// plan 시점에 ID가 확정된 리소스만 판단할 수 있음
+func terraformPublicSubnets(resources []tfResource) (map[string]bool, error) {
+	routeTables := make(map[string]*ec2types.RouteTable)
+	var associations []tfRouteTableAssociation
+	var routes []tfRoute
+	var subnets []tfSubnet
+
+	for _, res := range resources {
+		var err error
+		switch res.Type {
+		case "aws_route_table":
+			var rt tfRouteTable
+			if err = json.Unmarshal(res.Values, &rt); err == nil && rt.Id != "" {
+				table := &ec2types.RouteTable{RouteTableId: aws.String(rt.Id)}
+				for _, r := range rt.Route {
+					table.Routes = append(table.Routes, ec2types.Route{
+						DestinationCidrBlock: aws.String(r.CidrBlock),
+						GatewayId:            aws.String(r.GatewayId),
+					})
+				}
+				routeTables[rt.Id] = table
+			}
+		case "aws_route":
+			var r tfRoute
+			if err = json.Unmarshal(res.Values, &r); err == nil {
+				routes = append(routes, r)
+			}
+		case "aws_route_table_association":
+			var a tfRouteTableAssociation
+			if err = json.Unmarshal(res.Values, &a); err == nil {
+				associations = append(associations, a)
+			}
+		case "aws_subnet":
+			var s tfSubnet
+			if err = json.Unmarshal(res.Values, &s); err == nil && s.Id != "" {
+				subnets = append(subnets, s)
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s 해석 실패: %v", res.Address, err)
+		}
+	}
+
+	for _, r := range routes {
+		if table, ok := routeTables[r.RouteTableId]; ok {
+			table.Routes = append(table.Routes, ec2types.Route{
+				DestinationCidrBlock: aws.String(r.DestinationCidrBlock),
+				GatewayId:            aws.String(r.GatewayId),
+			})
+		}
+	}
+
+	publicSubnets := make(map[string]bool)
+	for _, s := range subnets {
+		if s.MapPublicIpOnLaunch {
+			publicSubnets[s.Id] = true
+		}
+	}
+
+	var tables []ec2types.RouteTable
+	var associated []string
+	for _, a := range associations {
+		table, ok := routeTables[a.RouteTableId]
+		if !ok || a.SubnetId == "" {
+			continue
+		}
+		table.Associations = append(table.Associations, ec2types.RouteTableAssociation{SubnetId: aws.String(a.SubnetId)})
+		associated = append(associated, a.SubnetId)
+	}
+	for _, table := range routeTables {
+		tables = append(tables, *table)
+	}
+
+	for _, subnetID := range associated {
+		if !publicSubnets[subnetID] {
+			publicSubnets[subnetID] = false
+		}
+	}
+	for _, subnetID := range security.PublicSubnets(associated, tables) {
+		publicSubnets[subnetID] = true
+	}
+
+	return publicSubnets, nil
+}
@@ -0,0 +1,27 @@
+package kube
+
+import (
+	"k8s.io/client-go/rest"
+)
+
+// 클라이언트 측 요청 속도 제한 기본값 (client-go 기본값 5/10은 대규모 클러스터 점검 시 지연이 커서 상향)
+const (
+	DefaultQPS   float32 = 50
+	DefaultBurst         = 100
+)
+
+// ConfigureRateLimit rest.Config에 클라이언트 측 QPS/Burst 설정
+// 0 이하의 값은 기본값을 사용하며, API 서버가 429(Too Many Requests)를 반환하면
+// client-go가 Retry-After 헤더에 따라 대기 후 재시도
+func ConfigureRateLimit(config *rest.Config, qps float32, burst int) {
+	if qps <= 0 {
+		qps = DefaultQPS
+	}
+	if burst <= 0 {
+		burst = DefaultBurst
+	}
+	config.QPS = qps
+	config.Burst = burst
+	// RateLimiter가 지정되어 있으면 QPS/Burst가 무시되므로 제거
+	config.RateLimiter = nil
+}
@@ -0,0 +1,70 @@
+// Package kube 대규모 클러스터 조회를 위한 Kubernetes 클라이언트 유틸리티
+package kube
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultPageSize 한 번의 List 요청으로 조회할 최대 항목 수 (kubectl 기본값과 동일)
+const DefaultPageSize = 500
+
+// ListFunc 타입별 클라이언트의 List 메서드 (예: client.CoreV1().Pods("").List)
+type ListFunc[L runtime.Object] func(ctx context.Context, opts metav1.ListOptions) (L, error)
+
+// ListAll Limit/Continue로 목록을 페이지 단위로 나누어 조회한 뒤 하나의 목록으로 합쳐 반환
+// 반환 값은 첫 페이지 객체에 전체 항목을 채운 것이므로 기존 List 결과와 같은 방식(.Items)으로 사용
+// 조회 도중 continue 토큰이 만료(410 Gone)되면 페이지 없이 전체 목록을 다시 조회
+func ListAll[L runtime.Object](ctx context.Context, list ListFunc[L], opts metav1.ListOptions) (L, error) {
+	if opts.Limit == 0 {
+		opts.Limit = DefaultPageSize
+	}
+
+	var result L
+	var items []runtime.Object
+	for page := 0; ; page++ {
+		current, err := list(ctx, opts)
+		if err != nil {
+			if page > 0 && apierrors.IsResourceExpired(err) {
+				opts.Limit, opts.Continue = 0, ""
+				return list(ctx, opts)
+			}
+			return result, err
+		}
+
+		pageItems, err := meta.ExtractList(current)
+		if err != nil {
+			return result, err
+		}
+		listMeta, err := meta.ListAccessor(current)
+		if err != nil {
+			return result, err
+		}
+
+		if page == 0 {
+			result = current
+		}
+		items = append(items, pageItems...)
+
+		if listMeta.GetContinue() == "" {
+			break
+		}
+		opts.Continue = listMeta.GetContinue()
+		// 이후 페이지는 첫 페이지와 같은 스냅샷에서 조회되므로 resourceVersion 지정 불가
+		opts.ResourceVersion = ""
+		opts.ResourceVersionMatch = ""
+	}
+
+	if err := meta.SetList(result, items); err != nil {
+		return result, err
+	}
+	if listMeta, err := meta.ListAccessor(result); err == nil {
+		listMeta.SetContinue("")
+		listMeta.SetRemainingItemCount(nil)
+	}
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package kube_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"eks-checklist/cmd/kube"
+	"eks-checklist/cmd/testutils"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// fakePodLister Limit/Continue를 지원하는 API 서버를 흉내 내는 List 함수
+// expireOnPage번째 페이지(1부터) 요청에서 continue 토큰 만료(410) 오류 반환
+func fakePodLister(total, expireOnPage int, calls *int) kube.ListFunc[*corev1.PodList] {
+	return func(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		*calls++
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+			if *calls == expireOnPage {
+				return nil, apierrors.NewResourceExpired("continue 토큰 만료")
+			}
+		}
+		end := total
+		if opts.Limit > 0 && start+int(opts.Limit) < total {
+			end = start + int(opts.Limit)
+		}
+
+		list := &corev1.PodList{}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i)}})
+		}
+		if end < total {
+			list.Continue = strconv.Itoa(end)
+		}
+		return list, nil
+	}
+}
+
+func TestListAll(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "kube_paging.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			var calls int
+			list := fakePodLister(tc["total"].(int), tc["expire_on_page"].(int), &calls)
+
+			pods, err := kube.ListAll(context.Background(), list, metav1.ListOptions{Limit: int64(tc["page_size"].(int))})
+			if err != nil {
+				t.Fatalf("Test '%s' failed: unexpected error: %v", testName, err)
+			}
+
+			expectItems := tc["expect_items"].(int)
+			if len(pods.Items) != expectItems {
+				t.Errorf("Test '%s' failed: expected %d items, got %d", testName, expectItems, len(pods.Items))
+			}
+			if calls != tc["expect_calls"].(int) {
+				t.Errorf("Test '%s' failed: expected %d calls, got %d", testName, tc["expect_calls"].(int), calls)
+			}
+			if pods.Continue != "" {
+				t.Errorf("Test '%s' failed: merged list should not have continue token", testName)
+			}
+			for i, pod := range pods.Items {
+				if pod.Name != fmt.Sprintf("pod-%d", i) {
+					t.Fatalf("Test '%s' failed: item %d out of order: %s", testName, i, pod.Name)
+				}
+			}
+		})
+	}
+}
+
+func TestListAllDefaultPageSize(t *testing.T) {
+	var limit int64
+	list := func(ctx context.Context, opts metav1.ListOptions) (*corev1.PodList, error) {
+		limit = opts.Limit
+		return &corev1.PodList{}, nil
+	}
+	if _, err := kube.ListAll(context.Background(), list, metav1.ListOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limit != kube.DefaultPageSize {
+		t.Errorf("expected limit %d, got %d", kube.DefaultPageSize, limit)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -136,7 +137,7 @@ func getKubeconfigWithContext(kubeconfigPath string, context string, awsProfile
 }
 
 // getKubeconfig 클러스터 선택 기능을 통합한 kubeconfig 로드 함수
-func getKubeconfig(kubeconfigPath string, kubeconfigContext string, awsProfile string) (string, rest.Config) {
+func getKubeconfig(kubeconfigPath string, kubeconfigContext string, awsProfile string) (string, rest.Config, error) {
 	var config *rest.Config
 	var err error
 	var selectedContext string
@@ -149,12 +150,11 @@ func getKubeconfig(kubeconfigPath string, kubeconfigContext string, awsProfile s
 		// InClusterConfig 사용
 		config, err = rest.InClusterConfig()
 		if err != nil {
-			fmt.Printf("인클러스터 설정을 로드하는 중 오류 발생: %v\n", err)
-			os.Exit(1)
+			return "", rest.Config{}, fmt.Errorf("인클러스터 설정을 로드하는 중 오류 발생: %v", err)
 		}
 
 		// 클러스터 내부에서는 AWS_PROFILE이 의미가 없으므로 빈 문자열 반환
-		return "", *config
+		return "", *config, nil
 	}
 
 	// Docker 환경에서 실행 중이지만 클러스터 외부인 경우
@@ -163,28 +163,25 @@ func getKubeconfig(kubeconfigPath string, kubeconfigContext string, awsProfile s
 		selectedContext = kubeconfigContext
 		config, err = getKubeconfigWithContext(kubeconfigPath, selectedContext, awsProfile)
 		if err != nil {
-			fmt.Printf("지정된 컨텍스트 '%s'를 로드하는 중 오류 발생: %v\n", selectedContext, err)
-			os.Exit(1)
+			return "", rest.Config{}, fmt.Errorf("지정된 컨텍스트 '%s'를 로드하는 중 오류 발생: %v", selectedContext, err)
 		}
 	} else {
 		// 대화형 선택 메뉴 표시
 		var selErr error
 		selectedContext, selErr = selectCluster(kubeconfigPath)
 		if selErr != nil {
-			fmt.Printf("클러스터 선택 중 오류 발생: %v\n", selErr)
-			os.Exit(1)
+			return "", rest.Config{}, fmt.Errorf("클러스터 선택 중 오류 발생: %v", selErr)
 		}
 
 		config, err = getKubeconfigWithContext(kubeconfigPath, selectedContext, awsProfile)
 		if err != nil {
-			fmt.Printf("선택한 컨텍스트 '%s'를 로드하는 중 오류 발생: %v\n", selectedContext, err)
-			os.Exit(1)
+			return "", rest.Config{}, fmt.Errorf("선택한 컨텍스트 '%s'를 로드하는 중 오류 발생: %v", selectedContext, err)
 		}
 	}
 
 	AWS_PROFILE = getAwsProfileFromContext(kubeconfigPath, selectedContext)
 
-	return AWS_PROFILE, *config
+	return AWS_PROFILE, *config, nil
 }
 
 // getAwsProfileFromContext는 주어진 컨텍스트에서 AWS_PROFILE 환경 변수 값을 추출합니다
@@ -232,13 +229,13 @@ type TokenPayload struct {
 }
 
 // getEksClusterName 함수 수정 - AWS 설정을 인자로 받도록 변경
-func getEksClusterName(kubeconfig rest.Config, cfg aws.Config) string {
+func getEksClusterName(ctx context.Context, kubeconfig rest.Config, cfg aws.Config) string {
 	// 클러스터 내부에서 실행 중인지 확인
 	if os.Getenv("IN_K8S") != "" {
 		fmt.Println("Kubernetes 클러스터 내부에서 실행 중입니다. 클러스터 이름을 자동으로 감지합니다.")
 
 		// 방법 1: ServiceAccount 토큰에서 클러스터 이름 추출
-		clusterName, err := getEksClusterNameFromServiceAccountToken(cfg)
+		clusterName, err := getEksClusterNameFromServiceAccountToken(ctx, cfg)
 		if err == nil && clusterName != "" {
 			fmt.Printf("ServiceAccount 토큰에서 클러스터 이름을 찾았습니다: %s\n", clusterName)
 			return clusterName
@@ -256,7 +253,7 @@ func getEksClusterName(kubeconfig rest.Config, cfg aws.Config) string {
 		clientset, err := kubernetes.NewForConfig(&kubeconfig)
 		if err == nil {
 			// kube-system 네임스페이스의 aws-auth ConfigMap 확인
-			configMap, err := clientset.CoreV1().ConfigMaps("kube-system").Get(context.Background(), "aws-auth", metav1.GetOptions{})
+			configMap, err := clientset.CoreV1().ConfigMaps("kube-system").Get(ctx, "aws-auth", metav1.GetOptions{})
 			if err == nil && configMap != nil {
 				if clusterName, ok := configMap.Data["cluster-name"]; ok {
 					fmt.Printf("aws-auth ConfigMap에서 클러스터 이름을 찾았습니다: %s\n", clusterName)
@@ -265,7 +262,7 @@ func getEksClusterName(kubeconfig rest.Config, cfg aws.Config) string {
 			}
 
 			// 또는 EKS 클러스터의 경우 아래와 같은 ConfigMap에서도 정보를 찾을 수 있음
-			configMap, err = clientset.CoreV1().ConfigMaps("kube-system").Get(context.Background(), "cluster-info", metav1.GetOptions{})
+			configMap, err = clientset.CoreV1().ConfigMaps("kube-system").Get(ctx, "cluster-info", metav1.GetOptions{})
 			if err == nil && configMap != nil {
 				if data, ok := configMap.Data["cluster.name"]; ok {
 					fmt.Printf("cluster-info ConfigMap에서 클러스터 이름을 찾았습니다: %s\n", data)
@@ -303,7 +300,7 @@ func getEksClusterName(kubeconfig rest.Config, cfg aws.Config) string {
 }
 
 // getEksClusterNameFromServiceAccountToken 함수 수정 - AWS 설정을 인자로 받도록 변경
-func getEksClusterNameFromServiceAccountToken(cfg aws.Config) (string, error) {
+func getEksClusterNameFromServiceAccountToken(ctx context.Context, cfg aws.Config) (string, error) {
 	// 서비스 어카운트 토큰 파일 경로
 	tokenPath := "/var/run/secrets/kubernetes.io/serviceaccount/token"
 
@@ -376,11 +373,11 @@ func getEksClusterNameFromServiceAccountToken(cfg aws.Config) (string, error) {
 	fmt.Printf("토큰에서 리전 정보를 찾아 설정했습니다: %s\n", region)
 
 	// AWS SDK를 사용하여 클러스터 ID로 클러스터 이름 조회
-	return getClusterNameByID(clusterId, region, cfg)
+	return getClusterNameByID(ctx, clusterId, region, cfg)
 }
 
 // getClusterNameByID 함수 수정 - AWS SDK를 사용하도록 변경
-func getClusterNameByID(clusterId, region string, cfg aws.Config) (string, error) {
+func getClusterNameByID(ctx context.Context, clusterId, region string, cfg aws.Config) (string, error) {
 	// 리전 설정이 있으면 해당 리전으로 설정 업데이트
 	if region != "" {
 		cfg.Region = region
@@ -389,16 +386,21 @@ func getClusterNameByID(clusterId, region string, cfg aws.Config) (string, error
 	// EKS 클라이언트 생성
 	eksClient := eks.NewFromConfig(cfg)
 
-	// EKS 클러스터 목록 가져오기
-	listClustersOutput, err := eksClient.ListClusters(context.TODO(), &eks.ListClustersInput{})
-	if err != nil {
-		return "", fmt.Errorf("EKS 클러스터 목록을 조회하는 중 오류 발생: %v", err)
+	// EKS 클러스터 목록 가져오기 (페이지 단위 조회)
+	var clusterNames []string
+	paginator := eks.NewListClustersPaginator(eksClient, &eks.ListClustersInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return "", fmt.Errorf("EKS 클러스터 목록을 조회하는 중 오류 발생: %v", err)
+		}
+		clusterNames = append(clusterNames, page.Clusters...)
 	}
 
 	// 각 클러스터에 대해 정보 조회하여 클러스터 ID 비교
-	for _, clusterName := range listClustersOutput.Clusters {
+	for _, clusterName := range clusterNames {
 		// 클러스터 상세 정보 가져오기
-		describeOutput, err := eksClient.DescribeCluster(context.TODO(), &eks.DescribeClusterInput{
+		describeOutput, err := eksClient.DescribeCluster(ctx, &eks.DescribeClusterInput{
 			Name: aws.String(clusterName),
 		})
 
@@ -425,21 +427,31 @@ func getClusterNameByID(clusterId, region string, cfg aws.Config) (string, error
 	return "", fmt.Errorf("클러스터 ID %s에 해당하는 EKS 클러스터를 찾을 수 없습니다", clusterId)
 }
 
-func createK8sClient(kubeconfig rest.Config) kubernetes.Interface {
+func createK8sClient(kubeconfig rest.Config) (kubernetes.Interface, error) {
 	client, err := kubernetes.NewForConfig(&kubeconfig)
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 
-	return client
+	return client, nil
 }
 
 // CreateDynamicClient: dynamic.Interface 생성
 func CreateDynamicClient(kubeconfig *rest.Config) (dynamic.Interface, error) {
 	dynamicClient, err := dynamic.NewForConfig(kubeconfig)
 	if err != nil {
-		panic(err.Error())
+		return nil, err
 	}
 
 	return dynamicClient, nil
 }
+
+// CreateMetadataClient: metadata.Interface 생성 (객체 본문 없이 메타데이터만 조회)
+func CreateMetadataClient(kubeconfig *rest.Config) (metadata.Interface, error) {
+	metadataClient, err := metadata.NewForConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadataClient, nil
+}
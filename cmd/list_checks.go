@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"eks-checklist/cmd/catalog"
+	"eks-checklist/cmd/checks"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listChecksFormat     string
+	listChecksCategories []string
+	listChecksIDs        []string
+)
+
+var listChecksCmd = &cobra.Command{
+	Use:   "list-checks",
+	Short: "등록된 체크 목록 출력 (ID, 카테고리, 점검 방식, 필요한 리소스/AWS API, Runbook)",
+	Long: `클러스터에 접속하지 않고 이 도구가 점검하는 항목을 출력합니다.
+
+  eks-checklist list-checks
+  eks-checklist list-checks --category "Security Check" --format json
+  eks-checklist list-checks --format markdown > CHECKS.md`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		selected, err := checks.Select(listChecksCategories, listChecksIDs)
+		if err != nil {
+			return err
+		}
+		entries := catalog.Entries(selected)
+
+		switch listChecksFormat {
+		case "table":
+			return catalog.WriteTable(os.Stdout, entries)
+		case "json":
+			return catalog.WriteJSON(os.Stdout, entries)
+		case "markdown":
+			return catalog.WriteMarkdown(os.Stdout, entries)
+		}
+		return fmt.Errorf("지원하지 않는 출력 형식: %s (table, json, markdown)", listChecksFormat)
+	},
+}
+
+func init() {
+	listChecksCmd.Flags().StringVar(&listChecksFormat, "format", "table", "출력 형식 (table, json, markdown)")
+	listChecksCmd.Flags().StringSliceVar(&listChecksCategories, "category", nil, "출력할 체크 카테고리 (비어 있으면 전체)")
+	listChecksCmd.Flags().StringSliceVar(&listChecksIDs, "check", nil, "출력할 체크 ID (비어 있으면 전체)")
+
+	rootCmd.AddCommand(listChecksCmd)
+}
@@ -0,0 +1,232 @@
+package manifests
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// clusterScopedKinds 네임스페이스를 기본값으로 채우지 않을 클러스터 범위 리소스
+var clusterScopedKinds = map[string]bool{
+	"Namespace":                      true,
+	"Node":                           true,
+	"PersistentVolume":               true,
+	"ClusterRole":                    true,
+	"ClusterRoleBinding":             true,
+	"PriorityClass":                  true,
+	"StorageClass":                   true,
+	"CSIDriver":                      true,
+	"RuntimeClass":                   true,
+	"IngressClass":                   true,
+	"CustomResourceDefinition":       true,
+	"APIService":                     true,
+	"ValidatingWebhookConfiguration": true,
+	"MutatingWebhookConfiguration":   true,
+	"NodePool":                       true,
+	"NodeClaim":                      true,
+	"EC2NodeClass":                   true,
+}
+
+// Set 매니페스트에서 읽어들인 객체 모음
+type Set struct {
+	// Objects client-go 스킴에 등록된 타입 객체
+	Objects []runtime.Object
+	// Unstructured 스킴에 등록되지 않은 객체 (CRD 기반 리소스 등)
+	Unstructured []*unstructured.Unstructured
+	// Skipped 해석하지 못해 건너뛴 문서와 사유
+	Skipped []string
+
+	defaultNamespace string
+	index            map[string]int
+}
+
+// NewSet 비어있는 Set 생성. 네임스페이스가 없는 객체에는 defaultNamespace를 사용
+func NewSet(defaultNamespace string) *Set {
+	if defaultNamespace == "" {
+		defaultNamespace = metav1.NamespaceDefault
+	}
+	return &Set{defaultNamespace: defaultNamespace, index: make(map[string]int)}
+}
+
+// Load 경로 목록에서 매니페스트를 읽어 Set 생성. "-"는 표준 입력을 의미
+func Load(paths []string, stdin io.Reader, defaultNamespace string) (*Set, error) {
+	set := NewSet(defaultNamespace)
+
+	for _, path := range paths {
+		if path == "-" {
+			if err := set.Read(stdin, "<stdin>"); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("경로 조회 실패 '%s': %v", path, err)
+		}
+
+		if !info.IsDir() {
+			if err := set.readFile(path); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() {
+				// 숨김 디렉토리(.git 등)는 건너뜀
+				if p != path && strings.HasPrefix(d.Name(), ".") {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !isManifestFile(d.Name()) {
+				return nil
+			}
+			return set.readFile(p)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return set, nil
+}
+
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	}
+	return false
+}
+
+func (s *Set) readFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("파일 열기 실패 '%s': %v", path, err)
+	}
+	defer file.Close()
+	return s.Read(file, path)
+}
+
+// Read 멀티 도큐먼트 YAML/JSON 스트림을 읽어 Set에 추가
+func (s *Set) Read(r io.Reader, source string) error {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+
+	for i := 1; ; i++ {
+		doc, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("매니페스트 읽기 실패 '%s': %v", source, err)
+		}
+
+		data, err := utilyaml.ToJSON(doc)
+		if err != nil {
+			s.skip(source, i, "YAML 파싱 실패: "+err.Error())
+			continue
+		}
+		data = bytes.TrimSpace(data)
+		if len(data) == 0 || string(data) == "null" {
+			continue // 빈 문서 또는 주석만 있는 문서
+		}
+
+		s.addJSON(data, source, i)
+	}
+}
+
+func (s *Set) addJSON(data []byte, source string, doc int) {
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		s.skip(source, doc, "객체 형식이 아님")
+		return
+	}
+	if typeMeta.Kind == "" || typeMeta.APIVersion == "" {
+		// values.yaml, Chart.yaml 등 Kubernetes 객체가 아닌 파일
+		s.skip(source, doc, "apiVersion/kind 없음")
+		return
+	}
+
+	gv, err := schema.ParseGroupVersion(typeMeta.APIVersion)
+	if err != nil {
+		s.skip(source, doc, "유효하지 않은 apiVersion: "+typeMeta.APIVersion)
+		return
+	}
+	if gv.Group == "kustomize.config.k8s.io" {
+		s.skip(source, doc, "kustomization 파일 (kustomize build 결과를 표준 입력으로 전달하세요)")
+		return
+	}
+
+	// kind: List 또는 *List 형식은 항목별로 처리
+	if strings.HasSuffix(typeMeta.Kind, "List") {
+		list := &unstructured.UnstructuredList{}
+		if err := list.UnmarshalJSON(data); err == nil {
+			for _, item := range list.Items {
+				itemData, err := item.MarshalJSON()
+				if err != nil {
+					s.skip(source, doc, "List 항목 직렬화 실패: "+err.Error())
+					continue
+				}
+				s.addJSON(itemData, source, doc)
+			}
+			return
+		}
+	}
+
+	obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(data, nil, nil)
+	if err != nil {
+		if !runtime.IsNotRegisteredError(err) {
+			s.skip(source, doc, fmt.Sprintf("%s 디코딩 실패: %v", typeMeta.Kind, err))
+			return
+		}
+		u := &unstructured.Unstructured{}
+		if err := u.UnmarshalJSON(data); err != nil {
+			s.skip(source, doc, fmt.Sprintf("%s 디코딩 실패: %v", typeMeta.Kind, err))
+			return
+		}
+		s.applyDefaultNamespace(u, u.GetKind())
+		s.Add(u)
+		return
+	}
+
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		s.skip(source, doc, err.Error())
+		return
+	}
+	s.applyDefaultNamespace(accessor, typeMeta.Kind)
+	s.Add(obj)
+}
+
+func (s *Set) skip(source string, doc int, reason string) {
+	s.Skipped = append(s.Skipped, fmt.Sprintf("%s (문서 %d): %s", source, doc, reason))
+}
+
+// applyDefaultNamespace 네임스페이스가 지정되지 않은 네임스페이스 범위 객체에 기본 네임스페이스 적용
+func (s *Set) applyDefaultNamespace(obj metav1.Object, kind string) {
+	if clusterScopedKinds[kind] {
+		obj.SetNamespace("")
+		return
+	}
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(s.defaultNamespace)
+	}
+}
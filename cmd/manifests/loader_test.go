@@ -0,0 +1,81 @@
+package manifests_test
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/manifests"
+	"eks-checklist/cmd/testutils"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoad(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "manifests_load.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		manifest := tc["manifest"].(string)
+
+		var expectPods []string
+		for _, p := range tc["expect_pods"].([]interface{}) {
+			expectPods = append(expectPods, p.(string))
+		}
+
+		t.Run(testName, func(t *testing.T) {
+			set, err := manifests.Load([]string{"-"}, strings.NewReader(manifest), "")
+			if err != nil {
+				t.Fatalf("매니페스트 로드 실패: %v", err)
+			}
+
+			if len(set.Objects) != tc["expect_objects"].(int) {
+				t.Errorf("Test '%s' failed: expected %d objects, got %d", testName, tc["expect_objects"].(int), len(set.Objects))
+			}
+			if len(set.Unstructured) != tc["expect_unstructured"].(int) {
+				t.Errorf("Test '%s' failed: expected %d unstructured objects, got %d", testName, tc["expect_unstructured"].(int), len(set.Unstructured))
+			}
+			if len(set.Skipped) != tc["expect_skipped"].(int) {
+				t.Errorf("Test '%s' failed: expected %d skipped documents, got %v", testName, tc["expect_skipped"].(int), set.Skipped)
+			}
+
+			client := set.Clientset()
+			ctx := context.TODO()
+
+			replicaSets, err := client.AppsV1().ReplicaSets("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("ReplicaSet 조회 실패: %v", err)
+			}
+			if len(replicaSets.Items) != tc["expect_replicasets"].(int) {
+				t.Errorf("Test '%s' failed: expected %d replicasets, got %d", testName, tc["expect_replicasets"].(int), len(replicaSets.Items))
+			}
+
+			jobs, err := client.BatchV1().Jobs("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Job 조회 실패: %v", err)
+			}
+			if len(jobs.Items) != tc["expect_jobs"].(int) {
+				t.Errorf("Test '%s' failed: expected %d jobs, got %d", testName, tc["expect_jobs"].(int), len(jobs.Items))
+			}
+
+			pods, err := client.CoreV1().Pods("").List(ctx, metav1.ListOptions{})
+			if err != nil {
+				t.Fatalf("Pod 조회 실패: %v", err)
+			}
+			// "<네임스페이스>:<소유자 종류>" 형식으로 비교
+			var gotPods []string
+			for _, pod := range pods.Items {
+				owner := ""
+				if len(pod.OwnerReferences) > 0 {
+					owner = pod.OwnerReferences[0].Kind
+				}
+				gotPods = append(gotPods, pod.Namespace+":"+owner)
+			}
+			sort.Strings(gotPods)
+			if strings.Join(gotPods, ",") != strings.Join(expectPods, ",") {
+				t.Errorf("Test '%s' failed: expected pods %v, got %v", testName, expectPods, gotPods)
+			}
+		})
+	}
+}
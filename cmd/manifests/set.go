@@ -0,0 +1,248 @@
+package manifests
+
+import (
+	"hash/fnv"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/metadata"
+	metadatafake "k8s.io/client-go/metadata/fake"
+)
+
+// Add 객체를 Set에 추가. 같은 종류/네임스페이스/이름의 객체가 있으면 나중에 읽은 객체로 교체
+func (s *Set) Add(obj runtime.Object) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	key := obj.GetObjectKind().GroupVersionKind().GroupKind().String() + "/" + accessor.GetNamespace() + "/" + accessor.GetName()
+
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		key = "unstructured:" + key
+		if i, exists := s.index[key]; exists {
+			s.Unstructured[i] = u
+			return
+		}
+		s.index[key] = len(s.Unstructured)
+		s.Unstructured = append(s.Unstructured, u)
+		return
+	}
+
+	if i, exists := s.index[key]; exists {
+		s.Objects[i] = obj
+		return
+	}
+	s.index[key] = len(s.Objects)
+	s.Objects = append(s.Objects, obj)
+}
+
+// Clientset 매니페스트 객체와 컨트롤러가 생성했을 하위 객체(ReplicaSet, Job, Pod)를 담은 fake 클라이언트 생성
+func (s *Set) Clientset() kubernetes.Interface {
+	objects := append([]runtime.Object{}, s.Objects...)
+	objects = append(objects, s.runtimeObjects()...)
+	return fake.NewSimpleClientset(objects...)
+}
+
+// DynamicClient 스킴에 등록되지 않은 객체를 담은 fake dynamic 클라이언트 생성
+func (s *Set) DynamicClient() dynamic.Interface {
+	listKinds := map[schema.GroupVersionResource]string{
+		// REL-013이 조회하는 NodeClaim
+		{Group: "karpenter.k8s.aws", Version: "v1", Resource: "nodeclaims"}: "NodeClaimList",
+		// SEC-018이 조회하는 EC2NodeClass
+		{Group: "karpenter.k8s.aws", Version: "v1", Resource: "ec2nodeclasses"}: "EC2NodeClassList",
+	}
+	var objects []runtime.Object
+	for _, u := range s.Unstructured {
+		gvk := u.GroupVersionKind()
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		listKinds[gvr] = gvk.Kind + "List"
+		objects = append(objects, u)
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+// MetadataClient 모든 객체(하위 객체 포함)의 메타데이터만 담은 fake metadata 클라이언트 생성
+func (s *Set) MetadataClient() metadata.Interface {
+	objects := append([]runtime.Object{}, s.Objects...)
+	objects = append(objects, s.runtimeObjects()...)
+	for _, u := range s.Unstructured {
+		objects = append(objects, u)
+	}
+
+	var partial []runtime.Object
+	for _, obj := range objects {
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		apiVersion, kind := obj.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+		partial = append(partial, &metav1.PartialObjectMetadata{
+			TypeMeta: metav1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            accessor.GetName(),
+				Namespace:       accessor.GetNamespace(),
+				Labels:          accessor.GetLabels(),
+				Annotations:     accessor.GetAnnotations(),
+				OwnerReferences: accessor.GetOwnerReferences(),
+			},
+		})
+	}
+	scheme := metadatafake.NewTestScheme()
+	metav1.AddMetaToScheme(scheme)
+	return metadatafake.NewSimpleMetadataClient(scheme, partial...)
+}
+
+// runtimeObjects 워크로드마다 컨트롤러가 생성했을 객체를 하나씩 합성
+// Pod는 복제본 수와 관계없이 워크로드당 하나만 만들어 동일한 위반 사항이 중복 보고되지 않도록 함
+func (s *Set) runtimeObjects() []runtime.Object {
+	var objects []runtime.Object
+
+	for _, obj := range s.Objects {
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			rs := replicaSetFor(o)
+			objects = append(objects, rs, podFor(o.Spec.Template, rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), rs.Name+"-"+suffix(rs.Name, 5)))
+		case *appsv1.ReplicaSet:
+			objects = append(objects, podFor(o.Spec.Template, o, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"), o.Name+"-"+suffix(o.Name, 5)))
+		case *appsv1.StatefulSet:
+			objects = append(objects, podFor(o.Spec.Template, o, appsv1.SchemeGroupVersion.WithKind("StatefulSet"), o.Name+"-0"))
+		case *appsv1.DaemonSet:
+			objects = append(objects, podFor(o.Spec.Template, o, appsv1.SchemeGroupVersion.WithKind("DaemonSet"), o.Name+"-"+suffix(o.Name, 5)))
+		case *batchv1.Job:
+			objects = append(objects, podFor(o.Spec.Template, o, batchv1.SchemeGroupVersion.WithKind("Job"), o.Name+"-"+suffix(o.Name, 5)))
+		case *batchv1.CronJob:
+			job := jobFor(o)
+			objects = append(objects, job, podFor(job.Spec.Template, job, batchv1.SchemeGroupVersion.WithKind("Job"), job.Name+"-"+suffix(job.Name, 5)))
+		case *corev1.Pod:
+			// 단독 Pod 매니페스트는 실행 중인 상태로 간주
+			if o.Status.Phase == "" {
+				o.Status.Phase = corev1.PodRunning
+			}
+			if o.Status.QOSClass == "" {
+				o.Status.QOSClass = qosClass(o.Spec)
+			}
+		}
+	}
+
+	return objects
+}
+
+func replicaSetFor(d *appsv1.Deployment) *appsv1.ReplicaSet {
+	replicas := int32(1)
+	if d.Spec.Replicas != nil {
+		replicas = *d.Spec.Replicas
+	}
+
+	template := *d.Spec.Template.DeepCopy()
+	hash := suffix(d.Namespace+"/"+d.Name, 10)
+	template.Labels = copyLabels(template.Labels)
+	template.Labels[appsv1.DefaultDeploymentUniqueLabelKey] = hash
+
+	return &appsv1.ReplicaSet{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "ReplicaSet"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            d.Name + "-" + hash,
+			Namespace:       d.Namespace,
+			Labels:          template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(d, appsv1.SchemeGroupVersion.WithKind("Deployment"))},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: &replicas,
+			Selector: d.Spec.Selector,
+			Template: template,
+		},
+	}
+}
+
+func jobFor(c *batchv1.CronJob) *batchv1.Job {
+	template := c.Spec.JobTemplate
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            c.Name + "-" + suffix(c.Namespace+"/"+c.Name, 8),
+			Namespace:       c.Namespace,
+			Labels:          template.Labels,
+			Annotations:     template.Annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(c, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+}
+
+func podFor(template corev1.PodTemplateSpec, owner metav1.Object, ownerKind schema.GroupVersionKind, name string) *corev1.Pod {
+	spec := *template.Spec.DeepCopy()
+	return &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       owner.GetNamespace(),
+			Labels:          copyLabels(template.Labels),
+			Annotations:     template.Annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, ownerKind)},
+		},
+		Spec: spec,
+		Status: corev1.PodStatus{
+			Phase:    corev1.PodRunning,
+			QOSClass: qosClass(spec),
+		},
+	}
+}
+
+// qosClass Pod의 리소스 설정으로 QoS 클래스를 계산
+func qosClass(spec corev1.PodSpec) corev1.PodQOSClass {
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+
+	hasAny := false
+	guaranteed := true
+	for _, c := range containers {
+		if len(c.Resources.Requests) > 0 || len(c.Resources.Limits) > 0 {
+			hasAny = true
+		}
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			limit, hasLimit := c.Resources.Limits[name]
+			request, hasRequest := c.Resources.Requests[name]
+			if !hasLimit || (hasRequest && request.Cmp(limit) != 0) {
+				guaranteed = false
+			}
+		}
+	}
+
+	switch {
+	case !hasAny:
+		return corev1.PodQOSBestEffort
+	case guaranteed:
+		return corev1.PodQOSGuaranteed
+	}
+	return corev1.PodQOSBurstable
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	result := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		result[k] = v
+	}
+	return result
+}
+
+// suffix 이름에서 결정적으로 생성한 n자리 접미사 (실행마다 동일한 이름을 사용하기 위함)
+func suffix(seed string, n int) string {
+	h := fnv.New64a()
+	h.Write([]byte(seed))
+	encoded := rand.SafeEncodeString(strconv.FormatUint(h.Sum64(), 36))
+	for len(encoded) < n {
+		encoded += encoded
+	}
+	return encoded[:n]
+}
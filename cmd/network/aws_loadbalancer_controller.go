@@ -5,13 +5,14 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckAwsLoadBalancerController checks if AWS Load Balancer Controller is installed via Deployment.
-func CheckAwsLoadBalancerController(client kubernetes.Interface) common.CheckResult {
+func CheckAwsLoadBalancerController(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-005] AWS Load Balancer Controller 사용",
 		Manual:    false,
@@ -19,7 +20,7 @@ func CheckAwsLoadBalancerController(client kubernetes.Interface) common.CheckRes
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/network/NET-005",
 	}
 
-	deploys, err := client.AppsV1().Deployments("").List(context.TODO(), v1.ListOptions{})
+	deploys, err := kube.ListAll(ctx, client.AppsV1().Deployments("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -61,7 +61,7 @@ func TestCheckAwsLoadBalancerController_YAML(t *testing.T) {
 			}
 
 			// CheckAwsLoadBalancerController 함수 실행 후 반환값 검증
-			result := network.CheckAwsLoadBalancerController(client)
+			result := network.CheckAwsLoadBalancerController(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckAwsLoadBalancerPodIp checks whether ALB/NLB uses Pod IP as its target.
-func CheckAwsLoadBalancerPodIp(controller_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
+func CheckAwsLoadBalancerPodIp(ctx context.Context, controller_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-006] ALB/NLB의 대상으로 Pod의 IP 사용",
 		Manual:    false,
@@ -28,7 +29,7 @@ func CheckAwsLoadBalancerPodIp(controller_installed common.CheckResult, client k
 	hasFailure := false
 
 	// 1. Ingress 체크 (ALB)
-	ingresses, err := client.NetworkingV1().Ingresses("").List(context.TODO(), v1.ListOptions{})
+	ingresses, err := kube.ListAll(ctx, client.NetworkingV1().Ingresses("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -46,7 +47,7 @@ func CheckAwsLoadBalancerPodIp(controller_installed common.CheckResult, client k
 	}
 
 	// 2. Service 체크 (NLB)
-	services, err := client.CoreV1().Services("").List(context.TODO(), v1.ListOptions{})
+	services, err := kube.ListAll(ctx, client.CoreV1().Services("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = fmt.Sprintf("Service 조회 실패: %v", err)
@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckVpcCniPrefixMode checks if the aws-node DaemonSet has ENABLE_PREFIX_DELEGATION=true.
-func CheckVpcCniPrefixMode(client kubernetes.Interface) common.CheckResult {
+func CheckVpcCniPrefixMode(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-003] VPC CNI의 Prefix 모드 사용",
 		Manual:    false,
@@ -19,7 +20,7 @@ func CheckVpcCniPrefixMode(client kubernetes.Interface) common.CheckResult {
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/network/NET-003",
 	}
 
-	daemonsets, err := client.AppsV1().DaemonSets("").List(context.TODO(), v1.ListOptions{})
+	daemonsets, err := kube.ListAll(ctx, client.AppsV1().DaemonSets("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -74,7 +74,7 @@ func TestCheckVpcCniPrefixMode_YAML(t *testing.T) {
 			}
 
 			// CheckVpcCniPrefixMode 함수 실행 후 반환값 검증
-			result := network.CheckVpcCniPrefixMode(client)
+			result := network.CheckVpcCniPrefixMode(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
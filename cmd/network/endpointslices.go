@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // EndpointSlicesCheck checks whether all services use EndpointSlices instead of Endpoints.
-func EndpointSlicesCheck(client kubernetes.Interface) common.CheckResult {
+func EndpointSlicesCheck(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-009] Endpoint 대신 EndpointSlices 사용",
 		Manual:    false,
@@ -19,14 +20,14 @@ func EndpointSlicesCheck(client kubernetes.Interface) common.CheckResult {
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/network/NET-009",
 	}
 
-	endpointSlices, err := client.DiscoveryV1().EndpointSlices("").List(context.TODO(), v1.ListOptions{})
+	endpointSlices, err := kube.ListAll(ctx, client.DiscoveryV1().EndpointSlices("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
 		return result
 	}
 
-	endpoints, err := client.CoreV1().Endpoints("").List(context.TODO(), v1.ListOptions{})
+	endpoints, err := kube.ListAll(ctx, client.CoreV1().Endpoints("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -66,7 +66,7 @@ func TestEndpointSlicesCheck_YAML(t *testing.T) {
 			}
 
 			// EndpointSlicesCheck 함수 실행 후 반환값 검증
-			result := network.EndpointSlicesCheck(client)
+			result := network.EndpointSlicesCheck(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
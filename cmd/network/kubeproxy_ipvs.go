@@ -12,7 +12,7 @@ import (
 )
 
 // CheckKubeProxyIPVSMode checks whether kube-proxy is set to use IPVS mode.
-func CheckKubeProxyIPVSMode(client kubernetes.Interface) common.CheckResult {
+func CheckKubeProxyIPVSMode(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-008] kube-proxy에 IPVS 모드 적용",
 		Manual:    false,
@@ -20,7 +20,7 @@ func CheckKubeProxyIPVSMode(client kubernetes.Interface) common.CheckResult {
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/network/NET-008",
 	}
 
-	configMap, err := client.CoreV1().ConfigMaps("kube-system").Get(context.TODO(), "kube-proxy-config", metav1.GetOptions{})
+	configMap, err := client.CoreV1().ConfigMaps("kube-system").Get(ctx, "kube-proxy-config", metav1.GetOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
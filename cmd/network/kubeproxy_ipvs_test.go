@@ -41,7 +41,7 @@ func TestCheckKubeProxyIPVSMode_YAML(t *testing.T) {
 			}
 
 			// CheckKubeProxyIPVSMode 함수 실행 후 반환값 검증
-			result := network.CheckKubeProxyIPVSMode(client)
+			result := network.CheckKubeProxyIPVSMode(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
@@ -9,11 +9,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckLoadBalancerUsage(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckLoadBalancerUsage(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[NET-004] 사용 사례에 맞는 로드밸런서 사용(ALB or NLB)",
 		Manual:     true,
@@ -22,14 +23,12 @@ func CheckLoadBalancerUsage(client kubernetes.Interface, cfg aws.Config, eksClus
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/network/NET-004",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-loadbalancer-usage")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
-	ctx := context.TODO()
-	ingList, err := client.NetworkingV1().Ingresses("").List(ctx, v1.ListOptions{})
+	ingList, err := kube.ListAll(ctx, client.NetworkingV1().Ingresses("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "Ingress 목록 조회 실패: " + err.Error()
 		return result
@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckReadinessGateEnabled checks if any namespace has pod readiness gate enabled.
-func CheckReadinessGateEnabled(controller_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
+func CheckReadinessGateEnabled(ctx context.Context, controller_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[NET-007] Pod Readiness Gate 적용",
 		Manual:    false,
@@ -25,7 +26,7 @@ func CheckReadinessGateEnabled(controller_installed common.CheckResult, client k
 		return result
 	}
 
-	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), v1.ListOptions{})
+	namespaces, err := kube.ListAll(ctx, client.CoreV1().Namespaces().List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
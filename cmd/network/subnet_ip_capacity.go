@@ -18,7 +18,7 @@ type EksCluster struct {
 }
 
 // CheckVpcSubnetIpCapacity collects IP usage stats for all subnets and prints them for manual inspection.
-func CheckVpcSubnetIpCapacity(eksCluster EksCluster, cfg aws.Config) common.CheckResult {
+func CheckVpcSubnetIpCapacity(ctx context.Context, eksCluster EksCluster, cfg aws.Config) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[NET-001] VPC 서브넷에 충분한 IP 대역대 확보",
 		Manual:     true,
@@ -32,7 +32,7 @@ func CheckVpcSubnetIpCapacity(eksCluster EksCluster, cfg aws.Config) common.Chec
 
 	for _, subnetId := range subnetIds {
 		// 서브넷 정보 조회
-		subnetOutput, err := ec2Client.DescribeSubnets(context.TODO(), &ec2.DescribeSubnetsInput{
+		subnetOutput, err := ec2Client.DescribeSubnets(ctx, &ec2.DescribeSubnetsInput{
 			SubnetIds: []string{subnetId},
 		})
 		if err != nil || len(subnetOutput.Subnets) == 0 {
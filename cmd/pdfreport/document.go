@@ -0,0 +1,235 @@
+package pdfreport
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf16"
+)
+
+// A4 크기 (pt)
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+)
+
+// 글꼴 리소스 이름
+// ASCII는 PDF 기본 글꼴(Helvetica), 그 외 문자는 Adobe-Korea1 CID 글꼴로 출력
+// CID 글꼴은 파일을 포함하지 않고 PDF 뷰어의 한글 글꼴로 대체되므로 바이너리 크기가 늘지 않음
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+	fontKorean  = "F3"
+)
+
+// color RGB 색상 (0~1)
+type color struct {
+	R, G, B float64
+}
+
+// link 페이지의 외부 링크 영역 (PDF 좌표)
+type link struct {
+	x1, y1, x2, y2 float64
+	url            string
+}
+
+// page 한 페이지의 그리기 명령과 링크
+// 좌표는 왼쪽 위를 원점으로 받고 PDF 좌표(왼쪽 아래 원점)로 변환해 기록
+type page struct {
+	content bytes.Buffer
+	links   []link
+}
+
+// document 보고서 생성에 필요한 최소한의 PDF 작성기
+type document struct {
+	pages []*page
+}
+
+func (d *document) addPage() *page {
+	p := &page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// rect 채워진 사각형
+func (p *page) rect(x, y, w, h float64, fill color) {
+	fmt.Fprintf(&p.content, "%.3f %.3f %.3f rg %.2f %.2f %.2f %.2f re f\n",
+		fill.R, fill.G, fill.B, x, pageHeight-y-h, w, h)
+}
+
+// line 직선
+func (p *page) line(x1, y1, x2, y2, width float64, stroke color) {
+	fmt.Fprintf(&p.content, "%.3f %.3f %.3f RG %.2f w %.2f %.2f m %.2f %.2f l S\n",
+		stroke.R, stroke.G, stroke.B, width, x1, pageHeight-y1, x2, pageHeight-y2)
+}
+
+// text y를 기준선으로 한 줄 출력 (줄바꿈 없음)
+func (p *page) text(x, y, size float64, bold bool, fill color, s string) {
+	runs := splitRuns(s)
+	if len(runs) == 0 {
+		return
+	}
+
+	fmt.Fprintf(&p.content, "BT %.3f %.3f %.3f rg %.3f %.3f %.3f RG %.2f %.2f Td\n",
+		fill.R, fill.G, fill.B, fill.R, fill.G, fill.B, x, pageHeight-y)
+	for _, r := range runs {
+		switch {
+		case r.korean:
+			// CID 글꼴에는 굵은 글꼴이 없으므로 채우기+외곽선(Tr 2)으로 굵게 표시
+			if bold {
+				fmt.Fprintf(&p.content, "2 Tr %.2f w ", size*0.03)
+			} else {
+				p.content.WriteString("0 Tr ")
+			}
+			fmt.Fprintf(&p.content, "/%s %.2f Tf <%s> Tj\n", fontKorean, size, utf16Hex(r.text))
+		case bold:
+			fmt.Fprintf(&p.content, "0 Tr /%s %.2f Tf (%s) Tj\n", fontBold, size, escapeLiteral(r.text))
+		default:
+			fmt.Fprintf(&p.content, "0 Tr /%s %.2f Tf (%s) Tj\n", fontRegular, size, escapeLiteral(r.text))
+		}
+	}
+	p.content.WriteString("ET\n")
+}
+
+// link 외부 URL로 이동하는 영역 추가
+func (p *page) link(x, y, w, h float64, url string) {
+	p.links = append(p.links, link{x1: x, y1: pageHeight - y - h, x2: x + w, y2: pageHeight - y, url: url})
+}
+
+// run 같은 글꼴로 출력하는 연속된 문자열
+type run struct {
+	text   string
+	korean bool
+}
+
+// splitRuns 문자열을 ASCII 구간과 그 외 구간으로 나눔 (제어 문자는 공백으로 바꿈)
+func splitRuns(s string) []run {
+	var runs []run
+	var b strings.Builder
+	current := false
+
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f {
+			r = ' '
+		}
+		korean := r > 0x7e
+		if b.Len() > 0 && korean != current {
+			runs = append(runs, run{text: b.String(), korean: current})
+			b.Reset()
+		}
+		current = korean
+		b.WriteRune(r)
+	}
+	if b.Len() > 0 {
+		runs = append(runs, run{text: b.String(), korean: current})
+	}
+	return runs
+}
+
+// textWidth 글자 크기 size로 출력했을 때의 폭 (pt)
+func textWidth(s string, size float64, bold bool) float64 {
+	widths := &helveticaWidths
+	if bold {
+		widths = &helveticaBoldWidths
+	}
+
+	total := 0
+	for _, r := range s {
+		if r < 0x20 || r == 0x7f {
+			r = ' '
+		}
+		if r <= 0x7e {
+			total += widths[r-0x20]
+		} else {
+			total += cidWidth
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// escapeLiteral PDF 리터럴 문자열 이스케이프
+func escapeLiteral(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+// utf16Hex UniKS-UTF16-H 인코딩용 UTF-16BE 16진 문자열
+func utf16Hex(s string) string {
+	var b strings.Builder
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	return b.String()
+}
+
+// write PDF 파일 작성
+// 객체 번호: 1 카탈로그, 2 페이지 트리, 3~6 글꼴, 이후 페이지/콘텐츠/링크 순서
+func (d *document) write(w io.Writer) error {
+	var buf bytes.Buffer
+	var offsets []int
+
+	object := func(body string) int {
+		offsets = append(offsets, buf.Len())
+		n := len(offsets)
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", n, body)
+		return n
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 페이지 객체 번호는 글꼴 다음부터 페이지마다 (페이지, 콘텐츠, 링크 수)만큼 차지
+	const firstPage = 7
+	pageRefs := make([]string, len(d.pages))
+	next := firstPage
+	for i, p := range d.pages {
+		pageRefs[i] = fmt.Sprintf("%d 0 R", next)
+		next += 2 + len(p.links)
+	}
+
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(pageRefs, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type0 /BaseFont /HYGoThic-Medium /Encoding /UniKS-UTF16-H /DescendantFonts [6 0 R] >>")
+	object("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /HYGoThic-Medium " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Korea1) /Supplement 1 >> /DW 1000 " +
+		"/FontDescriptor << /Type /FontDescriptor /FontName /HYGoThic-Medium /Flags 6 /FontBBox [-100 -142 1000 880] " +
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 720 /StemV 50 >> >>")
+
+	for _, p := range d.pages {
+		pageNum := len(offsets) + 1
+		var annots []string
+		for i := range p.links {
+			annots = append(annots, fmt.Sprintf("%d 0 R", pageNum+2+i))
+		}
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] "+
+			"/Resources << /Font << /%s 3 0 R /%s 4 0 R /%s 5 0 R >> >> /Contents %d 0 R /Annots [%s] >>",
+			pageWidth, pageHeight, fontRegular, fontBold, fontKorean, pageNum+1, strings.Join(annots, " ")))
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		if _, err := zw.Write(p.content.Bytes()); err != nil {
+			return err
+		}
+		if err := zw.Close(); err != nil {
+			return err
+		}
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.String()))
+
+		for _, l := range p.links {
+			object(fmt.Sprintf("<< /Type /Annot /Subtype /Link /Rect [%.2f %.2f %.2f %.2f] /Border [0 0 0] /A << /S /URI /URI (%s) >> >>",
+				l.x1, l.y1, l.x2, l.y2, escapeLiteral(l.url)))
+		}
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	_, err := w.Write(buf.Bytes())
+	return err
+}
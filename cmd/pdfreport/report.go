@@ -0,0 +1,489 @@
+package pdfreport
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// MaxResources 체크별로 PDF에 출력하는 최대 리소스 수 (나머지는 개수만 표시)
+const MaxResources = 30
+
+// Report PDF 보고서 데이터
+type Report struct {
+	Title   string
+	Date    string
+	Summary Summary
+	Results []Result
+}
+
+// Summary 상태별 체크 수 (FAIL에는 TIMEOUT이 포함됨)
+type Summary struct {
+	Pass          int
+	Fail          int
+	Manual        int
+	NotApplicable int
+	TimedOut      int
+}
+
+// Result 체크 결과 (Status: PASS, FAIL, TIMEOUT, MANUAL, N/A)
+type Result struct {
+	CheckName  string
+	Status     string
+	Category   string
+	FailureMsg string
+	Resources  []string
+	Runbook    string
+	// RunbookSections 내용 아래에 함께 출력할 런북 발췌 (비어 있으면 생략)
+	RunbookSections []RunbookSection
+}
+
+// RunbookSection 보고서에 포함하는 런북 구획 (Body는 줄바꿈만 있는 일반 텍스트)
+type RunbookSection struct {
+	Title string
+	Body  string
+}
+
+// 레이아웃 (pt)
+const (
+	margin       = 40.0
+	contentWidth = pageWidth - 2*margin
+	bottomLimit  = pageHeight - margin - 16 // 쪽 번호 영역 제외
+	cellPad      = 5.0
+	statusWidth  = 62.0
+	nameWidth    = 170.0
+	bodyWidth    = contentWidth - statusWidth - nameWidth
+)
+
+var (
+	colorPrimary = color{0.145, 0.388, 0.922}
+	colorPass    = color{0.063, 0.725, 0.506}
+	colorFail    = color{0.937, 0.267, 0.267}
+	colorManual  = color{0.961, 0.620, 0.043}
+	colorNA      = color{0.612, 0.639, 0.686}
+	colorText    = color{0.122, 0.161, 0.216}
+	colorMuted   = color{0.420, 0.447, 0.502}
+	colorBorder  = color{0.898, 0.906, 0.922}
+	colorLight   = color{0.953, 0.957, 0.965}
+	colorWhite   = color{1, 1, 1}
+)
+
+// statusColor 상태별 색상
+func statusColor(status string) color {
+	switch status {
+	case "PASS":
+		return colorPass
+	case "MANUAL":
+		return colorManual
+	case "N/A":
+		return colorNA
+	}
+	return colorFail
+}
+
+// textLine 표 셀의 한 줄
+type textLine struct {
+	text  string
+	size  float64
+	bold  bool
+	color color
+	url   string
+}
+
+func (t textLine) height() float64 {
+	return t.size * 1.45
+}
+
+func linesHeight(lines []textLine) float64 {
+	h := 0.0
+	for _, l := range lines {
+		h += l.height()
+	}
+	return h
+}
+
+// layout 위에서 아래로 내용을 배치하며 공간이 부족하면 새 페이지를 추가
+type layout struct {
+	doc  *document
+	page *page
+	y    float64
+}
+
+func (l *layout) newPage() {
+	l.page = l.doc.addPage()
+	l.y = margin
+}
+
+// Render 체크 결과로 PDF 보고서를 생성 (표지, 요약 차트, 카테고리별 결과 표, Runbook 링크)
+// 외부 프로그램 없이 Go로만 생성
+func Render(w io.Writer, report Report) error {
+	l := &layout{doc: &document{}}
+
+	l.cover(report)
+	l.newPage()
+	l.summary(report)
+
+	for _, category := range categories(report.Results) {
+		l.categoryTable(category, report.Results)
+	}
+
+	l.pageNumbers()
+	return l.doc.write(w)
+}
+
+// categories 결과에 나타난 순서대로 카테고리 목록
+func categories(results []Result) []string {
+	var order []string
+	seen := make(map[string]bool)
+	for _, r := range results {
+		if !seen[r.Category] {
+			seen[r.Category] = true
+			order = append(order, r.Category)
+		}
+	}
+	return order
+}
+
+// cover 표지: 제목, 생성일, 상태별 체크 수
+func (l *layout) cover(report Report) {
+	l.newPage()
+	p := l.page
+
+	p.rect(0, 0, pageWidth, 300, colorPrimary)
+	p.text(margin, 150, 28, true, colorWhite, report.Title)
+	p.text(margin, 185, 13, false, colorWhite, "Amazon EKS 모범 사례 점검 결과")
+	p.text(margin, 255, 11, false, colorWhite, "생성일: "+report.Date)
+
+	s := report.Summary
+	boxes := []struct {
+		label string
+		count int
+		color color
+	}{
+		{"통과", s.Pass, colorPass},
+		{"실패", s.Fail, colorFail},
+		{"수동 확인", s.Manual, colorManual},
+		{"적용 불가", s.NotApplicable, colorNA},
+	}
+	gap := 12.0
+	boxWidth := (contentWidth - gap*float64(len(boxes)-1)) / float64(len(boxes))
+	for i, b := range boxes {
+		x := margin + float64(i)*(boxWidth+gap)
+		p.rect(x, 340, boxWidth, 90, colorLight)
+		p.rect(x, 340, 5, 90, b.color)
+		p.text(x+18, 392, 30, true, b.color, fmt.Sprint(b.count))
+		p.text(x+18, 416, 11, false, colorMuted, b.label)
+	}
+
+	total := s.Pass + s.Fail + s.Manual
+	y := 470.0
+	if total > 0 {
+		p.text(margin, y, 12, false, colorText, fmt.Sprintf("전체 %d개 체크 중 %d개 통과 (%.0f%%)", total, s.Pass, float64(s.Pass)*100/float64(total)))
+		y += 20
+	}
+	if s.TimedOut > 0 {
+		p.text(margin, y, 11, false, colorFail, fmt.Sprintf("시간 초과(TIMEOUT): %d (실패에 포함)", s.TimedOut))
+	}
+}
+
+// summary 요약: 상태별 비율 막대와 카테고리별 결과 막대
+func (l *layout) summary(report Report) {
+	p := l.page
+	s := report.Summary
+
+	l.heading("요약")
+
+	// 상태별 비율 (누적 막대)
+	segments := []struct {
+		label string
+		count int
+		color color
+	}{
+		{"통과", s.Pass, colorPass},
+		{"실패", s.Fail, colorFail},
+		{"수동 확인", s.Manual, colorManual},
+		{"적용 불가", s.NotApplicable, colorNA},
+	}
+	total := 0
+	for _, seg := range segments {
+		total += seg.count
+	}
+
+	barHeight := 26.0
+	if total == 0 {
+		p.rect(margin, l.y, contentWidth, barHeight, colorLight)
+	} else {
+		x := margin
+		for _, seg := range segments {
+			w := contentWidth * float64(seg.count) / float64(total)
+			if w > 0 {
+				p.rect(x, l.y, w, barHeight, seg.color)
+			}
+			x += w
+		}
+	}
+	l.y += barHeight + 18
+
+	// 범례
+	x := margin
+	for _, seg := range segments {
+		percent := 0.0
+		if total > 0 {
+			percent = float64(seg.count) * 100 / float64(total)
+		}
+		label := fmt.Sprintf("%s %d (%.0f%%)", seg.label, seg.count, percent)
+		p.rect(x, l.y-8, 9, 9, seg.color)
+		p.text(x+14, l.y, 10, false, colorText, label)
+		x += 14 + textWidth(label, 10, false) + 22
+	}
+	l.y += 36
+
+	// 카테고리별 결과
+	l.heading("카테고리별 결과")
+	labelWidth := 150.0
+	countWidth := 120.0
+	chartWidth := contentWidth - labelWidth - countWidth
+	for _, category := range categories(report.Results) {
+		counts := map[string]int{}
+		sum := 0
+		for _, r := range report.Results {
+			if r.Category == category {
+				status := r.Status
+				if status == "TIMEOUT" {
+					status = "FAIL"
+				}
+				counts[status]++
+				sum++
+			}
+		}
+
+		l.ensure(24)
+		p = l.page
+		p.text(margin, l.y+12, 10, true, colorText, truncate(category, labelWidth-8, 10, true))
+		bx := margin + labelWidth
+		for _, status := range []string{"PASS", "FAIL", "MANUAL", "N/A"} {
+			w := chartWidth * float64(counts[status]) / float64(sum)
+			if w > 0 {
+				p.rect(bx, l.y, w, 16, statusColor(status))
+			}
+			bx += w
+		}
+		p.text(margin+labelWidth+chartWidth+8, l.y+12, 9, false, colorMuted,
+			fmt.Sprintf("P %d / F %d / M %d", counts["PASS"], counts["FAIL"], counts["MANUAL"]))
+		l.y += 24
+	}
+}
+
+// heading 구획 제목
+func (l *layout) heading(title string) {
+	l.ensure(40)
+	l.page.rect(margin, l.y, 4, 18, colorPrimary)
+	l.page.text(margin+12, l.y+14, 15, true, colorText, title)
+	l.y += 32
+}
+
+// ensure 높이 h만큼 남은 공간이 없으면 새 페이지 추가
+func (l *layout) ensure(h float64) {
+	if l.y+h > bottomLimit {
+		l.newPage()
+	}
+}
+
+// categoryTable 카테고리별 결과 표 (상태, 체크 항목, 내용)
+func (l *layout) categoryTable(category string, results []Result) {
+	l.newPage()
+	l.heading(category)
+	l.tableHeader()
+
+	for _, r := range results {
+		if r.Category != category {
+			continue
+		}
+		l.row(category, r)
+	}
+}
+
+func (l *layout) tableHeader() {
+	p := l.page
+	p.rect(margin, l.y, contentWidth, 20, colorLight)
+	p.text(margin+cellPad, l.y+14, 9, true, colorMuted, "상태")
+	p.text(margin+statusWidth+cellPad, l.y+14, 9, true, colorMuted, "체크 항목")
+	p.text(margin+statusWidth+nameWidth+cellPad, l.y+14, 9, true, colorMuted, "내용")
+	l.y += 20
+}
+
+// row 결과 한 행 출력
+// 내용이 한 페이지에 들어가지 않으면 다음 페이지에 이어서 출력하고 표 머리글을 반복
+func (l *layout) row(category string, r Result) {
+	var name []textLine
+	for _, line := range wrap(r.CheckName, nameWidth-2*cellPad, 9, true) {
+		name = append(name, textLine{text: line, size: 9, bold: true, color: colorText})
+	}
+	if r.Runbook != "" {
+		name = append(name, textLine{text: "Runbook 보기", size: 8, color: colorPrimary, url: r.Runbook})
+	}
+
+	body := bodyLines(r)
+	first := true
+	for first || len(body) > 0 {
+		avail := bottomLimit - l.y - 2*cellPad
+		fresh := bottomLimit - margin - 52 - 2*cellPad // 새 페이지에서 제목과 머리글을 제외한 공간
+
+		// 이 페이지에 들어가는 내용 줄 수
+		n, h := 0, 0.0
+		for n < len(body) && h+body[n].height() <= avail {
+			h += body[n].height()
+			n++
+		}
+		// 이미 새 페이지 첫 행이면 더 넘기지 않고 들어가는 만큼 출력
+		atTop := l.y <= margin+52
+		needName := first && linesHeight(name) > avail
+		splitSmall := first && n < len(body) && linesHeight(body) <= fresh
+		if !atTop && (needName || (n == 0 && len(body) > 0) || splitSmall) {
+			l.continuePage(category)
+			continue
+		}
+
+		rowHeight := h
+		if first {
+			rowHeight = math.Max(rowHeight, linesHeight(name))
+			rowHeight = math.Max(rowHeight, 14)
+		}
+		rowHeight += 2 * cellPad
+
+		p := l.page
+		if first {
+			pillWidth := textWidth(r.Status, 8, true) + 12
+			p.rect(margin+cellPad, l.y+cellPad, pillWidth, 14, statusColor(r.Status))
+			p.text(margin+cellPad+6, l.y+cellPad+10, 8, true, colorWhite, r.Status)
+			drawLines(p, margin+statusWidth+cellPad, l.y+cellPad, name)
+		}
+		drawLines(p, margin+statusWidth+nameWidth+cellPad, l.y+cellPad, body[:n])
+		p.line(margin, l.y+rowHeight, margin+contentWidth, l.y+rowHeight, 0.5, colorBorder)
+
+		l.y += rowHeight
+		body = body[n:]
+		first = false
+	}
+}
+
+// continuePage 표를 다음 페이지에 이어서 출력
+func (l *layout) continuePage(category string) {
+	l.newPage()
+	l.heading(category + " (계속)")
+	l.tableHeader()
+}
+
+// bodyLines 실패 사유와 리소스 목록 (리소스는 MaxResources개까지), 런북 발췌
+func bodyLines(r Result) []textLine {
+	var lines []textLine
+	if r.Status != "PASS" && r.FailureMsg != "" {
+		for _, line := range wrap(r.FailureMsg, bodyWidth-2*cellPad, 9, false) {
+			lines = append(lines, textLine{text: line, size: 9, color: colorText})
+		}
+	}
+
+	for i, resource := range r.Resources {
+		if i == MaxResources {
+			lines = append(lines, textLine{
+				text: fmt.Sprintf("외 %d개 리소스 생략 (HTML 보고서에서 전체 확인)", len(r.Resources)-MaxResources),
+				size: 8, color: colorMuted,
+			})
+			break
+		}
+		for j, line := range wrap(resource, bodyWidth-2*cellPad-8, 8, false) {
+			prefix := "  "
+			if j == 0 {
+				prefix = "- "
+			}
+			lines = append(lines, textLine{text: prefix + line, size: 8, color: colorMuted})
+		}
+	}
+
+	for _, section := range r.RunbookSections {
+		if len(lines) > 0 {
+			lines = append(lines, textLine{size: 4})
+		}
+		lines = append(lines, textLine{text: "Runbook - " + section.Title, size: 8, bold: true, color: colorPrimary})
+		for _, line := range wrap(section.Body, bodyWidth-2*cellPad, 8, false) {
+			lines = append(lines, textLine{text: line, size: 8, color: colorText})
+		}
+	}
+
+	if len(lines) == 0 {
+		lines = append(lines, textLine{text: "-", size: 9, color: colorMuted})
+	}
+	return lines
+}
+
+// drawLines 셀 안에 줄 단위로 출력 (URL이 있는 줄은 링크 영역 추가)
+func drawLines(p *page, x, y float64, lines []textLine) {
+	for _, line := range lines {
+		baseline := y + line.size
+		p.text(x, baseline, line.size, line.bold, line.color, line.text)
+		if line.url != "" {
+			p.link(x, y, textWidth(line.text, line.size, line.bold), line.height(), line.url)
+		}
+		y += line.height()
+	}
+}
+
+// pageNumbers 표지를 제외한 페이지에 쪽 번호 출력
+func (l *layout) pageNumbers() {
+	total := len(l.doc.pages)
+	for i, p := range l.doc.pages {
+		if i == 0 {
+			continue
+		}
+		label := fmt.Sprintf("EKS Checklist  |  %d / %d", i+1, total)
+		p.text(pageWidth-margin-textWidth(label, 8, false), pageHeight-margin+4, 8, false, colorMuted, label)
+	}
+}
+
+// truncate 폭을 넘으면 잘라서 ... 표시
+func truncate(s string, width, size float64, bold bool) string {
+	if textWidth(s, size, bold) <= width {
+		return s
+	}
+	runes := []rune(s)
+	for len(runes) > 0 && textWidth(string(runes)+"...", size, bold) > width {
+		runes = runes[:len(runes)-1]
+	}
+	return string(runes) + "..."
+}
+
+// wrap 폭에 맞게 줄바꿈 (공백 단위, 한 단어가 폭보다 길면 글자 단위)
+func wrap(s string, width, size float64, bold bool) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if textWidth(candidate, size, bold) <= width {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			// 단어 하나가 폭보다 길면 글자 단위로 나눔
+			for textWidth(word, size, bold) > width {
+				runes := []rune(word)
+				n := len(runes)
+				for n > 1 && textWidth(string(runes[:n]), size, bold) > width {
+					n--
+				}
+				lines = append(lines, string(runes[:n]))
+				word = string(runes[n:])
+			}
+			line = word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
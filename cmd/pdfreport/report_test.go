@@ -0,0 +1,108 @@
+package pdfreport_test
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/pdfreport"
+	"eks-checklist/cmd/testutils"
+)
+
+var xrefEntryPattern = regexp.MustCompile(`(\d{10}) 00000 n `)
+
+// checkStructure xref 표의 오프셋이 각 객체 시작 위치를 가리키는지 확인
+func checkStructure(t *testing.T, data []byte) {
+	t.Helper()
+	if !bytes.HasPrefix(data, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("missing PDF header or trailer")
+	}
+
+	startxref := bytes.LastIndex(data, []byte("startxref\n"))
+	offset, err := strconv.Atoi(strings.Fields(string(data[startxref+len("startxref\n"):]))[0])
+	if err != nil || !bytes.HasPrefix(data[offset:], []byte("xref\n")) {
+		t.Fatalf("startxref does not point to xref table: %d", offset)
+	}
+
+	for i, m := range xrefEntryPattern.FindAllSubmatch(data[offset:], -1) {
+		objOffset, _ := strconv.Atoi(string(m[1]))
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(data[objOffset:], []byte(want)) {
+			t.Errorf("xref entry %d does not point to object start", i+1)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "pdf_report.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			report := pdfreport.Report{Title: "EKS 체크리스트 결과 보고서", Date: "2025-01-02 03:04:05"}
+			for _, item := range tc["results"].([]interface{}) {
+				r := item.(map[string]interface{})
+				result := pdfreport.Result{
+					CheckName:  r["name"].(string),
+					Status:     r["status"].(string),
+					Category:   r["category"].(string),
+					FailureMsg: "실패 사유",
+				}
+				for i := 0; i < r["resources"].(int); i++ {
+					result.Resources = append(result.Resources, fmt.Sprintf("Resource-%d", i))
+				}
+				if r["runbook"].(bool) {
+					result.Runbook = "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-001"
+				}
+				for _, section := range testutils.ToStrings(r["runbook_sections"]) {
+					result.RunbookSections = append(result.RunbookSections, pdfreport.RunbookSection{Title: section, Body: section + " 본문\n- 항목"})
+				}
+				report.Results = append(report.Results, result)
+			}
+
+			var buf bytes.Buffer
+			if err := pdfreport.Render(&buf, report); err != nil {
+				t.Fatal(err)
+			}
+			data := buf.Bytes()
+			checkStructure(t, data)
+
+			if pages := fmt.Sprintf("/Count %d ", tc["expect_pages"].(int)); !bytes.Contains(data, []byte(pages)) {
+				t.Errorf("Test '%s' failed: expected %s", testName, pages)
+			}
+			if links := bytes.Count(data, []byte("/S /URI")); links != tc["expect_links"].(int) {
+				t.Errorf("Test '%s' failed: expected %d runbook links, got %d", testName, tc["expect_links"].(int), links)
+			}
+
+			content := testutils.PDFContents(t, data)
+			for _, expect := range testutils.ToStrings(tc["expect_content"]) {
+				if !strings.Contains(content, expect) {
+					t.Errorf("Test '%s' failed: expected content to contain %q", testName, expect)
+				}
+			}
+			for _, missing := range testutils.ToStrings(tc["expect_missing"]) {
+				if strings.Contains(content, missing) {
+					t.Errorf("Test '%s' failed: expected content not to contain %q", testName, missing)
+				}
+			}
+		})
+	}
+}
+
+// TestRenderSplitsLongRows 한 페이지를 넘는 행은 다음 페이지에 이어서 출력
+func TestRenderSplitsLongRows(t *testing.T) {
+	long := strings.Repeat("매우 긴 실패 사유입니다. ", 400)
+	report := pdfreport.Report{Results: []pdfreport.Result{{CheckName: "[SEC-005] test", Status: "FAIL", Category: "Security", FailureMsg: long}}}
+
+	var buf bytes.Buffer
+	if err := pdfreport.Render(&buf, report); err != nil {
+		t.Fatal(err)
+	}
+	checkStructure(t, buf.Bytes())
+	if bytes.Contains(buf.Bytes(), []byte("/Count 3 ")) {
+		t.Error("expected long row to continue on following pages")
+	}
+}
@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+	"eks-checklist/cmd/preflight"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/spf13/cobra"
+)
+
+var (
+	preflightCategories []string
+	preflightCheckIDs   []string
+	preflightWatch      bool
+	preflightFormat     string
+	preflightKind       string
+	preflightRoleName   string
+)
+
+var preflightCmd = &cobra.Command{
+	Use:   "preflight",
+	Short: "선택한 체크 실행에 필요한 Kubernetes/AWS 권한이 있는지 사전 점검",
+	Long: `체크를 실행하기 전에 현재 자격 증명으로 필요한 권한이 모두 있는지 확인합니다.
+권한이 없으면 점검 도중 해당 체크가 FAIL로 표시되므로, 실행 전에 부족한 권한을 확인할 때 사용합니다.
+
+  - Kubernetes: 체크가 조회하는 리소스별 verb를 SelfSubjectAccessReview로 확인
+  - AWS: 체크가 호출하는 API를 IAM SimulatePrincipalPolicy로 확인 (iam:SimulatePrincipalPolicy 권한 필요)
+
+부족한 권한이 있으면 종료 코드 1로 종료합니다.
+필요한 권한을 ClusterRole 또는 IAM 정책으로 만들려면 'preflight generate'를 사용합니다.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if preflightFormat != "text" && preflightFormat != "json" {
+			return fmt.Errorf("지원하지 않는 출력 형식: %s (text, json)", preflightFormat)
+		}
+		selected, err := checks.Select(preflightCategories, preflightCheckIDs)
+		if err != nil {
+			return err
+		}
+		ctx := cmd.Context()
+
+		awsProfile, kubeconfig, err := getKubeconfig(kubeconfigPath, kubeconfigContext, awsProfile)
+		if err != nil {
+			return err
+		}
+		kube.ConfigureRateLimit(&kubeconfig, kubeQPS, kubeBurst)
+		client, err := createK8sClient(kubeconfig)
+		if err != nil {
+			return fmt.Errorf("Kubernetes 클라이언트 생성 실패: %v", err)
+		}
+
+		findings := preflight.ReviewKube(ctx, client, preflight.KubePermissions(selected, preflightWatch))
+
+		actions := preflight.AWSActions(selected)
+		var principal string
+		cfg, err := GetAWSConfig(awsProfile)
+		if err == nil {
+			principal, err = preflight.CallerPrincipal(ctx, cfg)
+		}
+		if err == nil {
+			var awsFindings []preflight.Finding
+			awsFindings, err = preflight.ReviewAWS(ctx, iam.NewFromConfig(cfg), principal, actions)
+			findings = append(findings, awsFindings...)
+		} else {
+			for _, action := range actions {
+				findings = append(findings, preflight.Finding{Kind: preflight.KindAWS, Permission: action.Action, Status: preflight.StatusUnknown, Checks: action.Checks})
+			}
+		}
+		awsErr := err
+
+		if preflightFormat == "json" {
+			data, err := json.MarshalIndent(struct {
+				Principal string              `json:"awsPrincipal,omitempty"`
+				AWSError  string              `json:"awsError,omitempty"`
+				Findings  []preflight.Finding `json:"findings"`
+			}{principal, errString(awsErr), findings}, "", "  ")
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(data))
+		} else {
+			printPreflight(findings, principal, awsErr)
+		}
+
+		if missing := preflight.Missing(findings); len(missing) > 0 {
+			os.Exit(1)
+		}
+		return nil
+	},
+}
+
+var preflightGenerateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "선택한 체크 실행에 필요한 최소 권한 ClusterRole 또는 IAM 정책 생성",
+	Long: `체크 선택(--category, --check)에 맞는 최소 권한 정책을 표준 출력으로 생성합니다.
+
+  eks-checklist preflight generate --kind rbac > clusterrole.yaml
+  eks-checklist preflight generate --kind iam --category "Security Check" > policy.json`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		selected, err := checks.Select(preflightCategories, preflightCheckIDs)
+		if err != nil {
+			return err
+		}
+
+		var data []byte
+		switch preflightKind {
+		case "rbac":
+			data, err = preflight.ClusterRoleYAML(preflight.ClusterRole(preflightRoleName, preflight.KubePermissions(selected, preflightWatch)))
+		case "iam":
+			data, err = preflight.IAMPolicyJSON(preflight.IAMPolicy(preflight.AWSActions(selected)))
+		default:
+			return fmt.Errorf("지원하지 않는 정책 종류: %s (rbac, iam)", preflightKind)
+		}
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(data)
+		return err
+	},
+}
+
+// printPreflight 권한 점검 결과를 종류별로 출력 (부족한 권한은 필요한 체크와 함께 표시)
+func printPreflight(findings []preflight.Finding, principal string, awsErr error) {
+	sections := []struct{ kind, title string }{
+		{preflight.KindKubernetes, "Kubernetes 권한 (SelfSubjectAccessReview)"},
+		{preflight.KindAWS, "AWS 권한 (IAM SimulatePrincipalPolicy)"},
+	}
+	for _, section := range sections {
+		fmt.Printf("\n===============[%s]===============\n", section.title)
+		if section.kind == preflight.KindAWS {
+			if principal != "" {
+				fmt.Printf("주체: %s\n", principal)
+			}
+			if awsErr != nil {
+				fmt.Printf(common.Yellow+"⚠ AWS 권한을 확인할 수 없습니다: %v"+common.Reset+"\n", awsErr)
+			}
+		}
+		for _, f := range findings {
+			if f.Kind != section.kind {
+				continue
+			}
+			checkIDs := ""
+			if len(f.Checks) > 0 {
+				checkIDs = " ← " + strings.Join(f.Checks, ", ")
+			}
+			switch f.Status {
+			case preflight.StatusAllowed:
+				fmt.Printf(common.Green+"✔ %s"+common.Reset+"\n", f.Permission)
+			case preflight.StatusDenied:
+				fmt.Printf(common.Red+"✖ %s%s"+common.Reset+"\n", f.Permission, checkIDs)
+			default:
+				fmt.Printf(common.Yellow+"? %s%s"+common.Reset+"\n", f.Permission, checkIDs)
+			}
+		}
+	}
+
+	missing := preflight.Missing(findings)
+	fmt.Println("\n===============[Preflight Summary]===============")
+	if len(missing) == 0 {
+		fmt.Printf(common.Green+"✔ 필요한 권한이 모두 있습니다 (%d개)"+common.Reset+"\n", len(findings))
+		return
+	}
+	fmt.Printf(common.Red+"✖ 부족하거나 확인할 수 없는 권한: %d/%d개"+common.Reset+"\n", len(missing), len(findings))
+	fmt.Println("  └─ 'eks-checklist preflight generate --kind rbac|iam'으로 필요한 권한 정책을 생성할 수 있습니다")
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+func init() {
+	preflightCmd.PersistentFlags().StringSliceVar(&preflightCategories, "category", nil, "점검할 체크 카테고리 (예: \"Security Check\", 비어 있으면 전체)")
+	preflightCmd.PersistentFlags().StringSliceVar(&preflightCheckIDs, "check", nil, "점검할 체크 ID (예: SEC-002,REL-001, 비어 있으면 전체)")
+	preflightCmd.PersistentFlags().BoolVar(&preflightWatch, "watch", false, "감시 모드(--watch)에 필요한 list/watch 권한 포함")
+	preflightCmd.Flags().StringVar(&preflightFormat, "format", "text", "출력 형식 (text, json)")
+	preflightGenerateCmd.Flags().StringVar(&preflightKind, "kind", "", "생성할 정책 종류 (rbac: ClusterRole YAML, iam: IAM 정책 JSON)")
+	preflightGenerateCmd.Flags().StringVar(&preflightRoleName, "name", preflight.DefaultRoleName, "생성할 ClusterRole 이름")
+	_ = preflightGenerateCmd.MarkFlagRequired("kind")
+
+	preflightCmd.AddCommand(preflightGenerateCmd)
+	rootCmd.AddCommand(preflightCmd)
+}
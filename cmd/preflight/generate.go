@@ -0,0 +1,122 @@
+package preflight
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultRoleName 생성하는 ClusterRole 기본 이름 (manifest/output-html-job.yaml과 동일)
+const DefaultRoleName = "eks-checklist-role"
+
+// ClusterRole 권한 목록으로 최소 권한 ClusterRole 생성
+// API 그룹별로 같은 verb 조합을 사용하는 리소스를 하나의 규칙으로 묶음
+func ClusterRole(name string, perms []KubePermission) *rbacv1.ClusterRole {
+	// group -> resource -> verbs
+	verbs := make(map[string]map[string][]string)
+	var groups []string
+	for _, p := range perms {
+		if _, ok := verbs[p.Group]; !ok {
+			verbs[p.Group] = make(map[string][]string)
+			groups = append(groups, p.Group)
+		}
+		verbs[p.Group][p.Resource] = appendUnique(verbs[p.Group][p.Resource], p.Verb)
+	}
+	sort.Strings(groups)
+
+	role := &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "rbac.authorization.k8s.io/v1", Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+	for _, group := range groups {
+		// verb 조합 -> 리소스 (verb 조합 순서는 처음 나온 순서)
+		byVerbs := make(map[string][]string)
+		var verbKeys []string
+		for _, resource := range sortedKeys(verbs[group]) {
+			vs := verbs[group][resource]
+			sort.Strings(vs)
+			key := strings.Join(vs, ",")
+			if _, ok := byVerbs[key]; !ok {
+				verbKeys = append(verbKeys, key)
+			}
+			byVerbs[key] = append(byVerbs[key], resource)
+		}
+		for _, key := range verbKeys {
+			role.Rules = append(role.Rules, rbacv1.PolicyRule{
+				APIGroups: []string{group},
+				Resources: byVerbs[key],
+				Verbs:     strings.Split(key, ","),
+			})
+		}
+	}
+	return role
+}
+
+// ClusterRoleYAML ClusterRole을 kubectl apply로 적용할 수 있는 YAML로 변환
+func ClusterRoleYAML(role *rbacv1.ClusterRole) ([]byte, error) {
+	data, err := json.Marshal(role)
+	if err != nil {
+		return nil, err
+	}
+	// creationTimestamp: null 등 비어 있는 메타데이터 필드 제거
+	var obj map[string]interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	obj["metadata"] = map[string]interface{}{"name": role.Name}
+	return yaml.Marshal(obj)
+}
+
+// PolicyDocument IAM 정책 문서
+type PolicyDocument struct {
+	Version   string            `json:"Version"`
+	Statement []PolicyStatement `json:"Statement"`
+}
+
+// PolicyStatement IAM 정책 문장
+type PolicyStatement struct {
+	Sid      string   `json:"Sid,omitempty"`
+	Effect   string   `json:"Effect"`
+	Action   []string `json:"Action"`
+	Resource string   `json:"Resource"`
+}
+
+// IAMPolicy AWS 액션 목록으로 최소 권한 IAM 정책 생성
+// 체크는 조회(Describe/List/Get) API만 호출하므로 리소스는 "*"로 지정
+func IAMPolicy(actions []AWSAction) PolicyDocument {
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, action.Action)
+	}
+	return PolicyDocument{
+		Version: "2012-10-17",
+		Statement: []PolicyStatement{{
+			Sid:      "EksChecklistReadOnly",
+			Effect:   "Allow",
+			Action:   names,
+			Resource: "*",
+		}},
+	}
+}
+
+// IAMPolicyJSON IAM 정책 문서를 들여쓰기한 JSON으로 변환
+func IAMPolicyJSON(policy PolicyDocument) ([]byte, error) {
+	data, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,142 @@
+// Package preflight 선택한 체크에 필요한 Kubernetes/AWS 권한 계산, 사전 권한 점검, 최소 권한 정책 생성
+package preflight
+
+import (
+	"sort"
+	"strings"
+
+	"eks-checklist/cmd/checks"
+)
+
+// baseKubePermissions 체크와 무관하게 실행 시 필요한 권한
+// (클러스터 내부 실행 시 aws-auth, cluster-info ConfigMap으로 클러스터 이름 확인)
+var baseKubePermissions = []KubePermission{
+	{Resource: "configmaps", Verb: "get"},
+}
+
+// baseAWSActions 체크와 무관하게 실행 시 호출하는 AWS API
+// (클러스터 정보 조회, 클러스터 내부 실행 시 클러스터 ID로 클러스터 이름 확인)
+var baseAWSActions = []string{
+	"eks:DescribeCluster",
+	"eks:ListClusters",
+}
+
+// KubePermission Kubernetes RBAC 권한 한 건
+type KubePermission struct {
+	Group    string
+	Resource string
+	Verb     string
+	// Checks 이 권한이 필요한 체크 ID (실행 시 공통으로 필요한 권한이면 비어 있음)
+	Checks []string
+}
+
+// String "verb resource.group" 형식 (core 그룹은 resource만 표기)
+func (p KubePermission) String() string {
+	if p.Group == "" {
+		return p.Verb + " " + p.Resource
+	}
+	return p.Verb + " " + p.Resource + "." + p.Group
+}
+
+// AWSAction AWS IAM 액션 한 건
+type AWSAction struct {
+	Action string
+	// Checks 이 액션이 필요한 체크 ID (실행 시 공통으로 필요한 액션이면 비어 있음)
+	Checks []string
+}
+
+// KubePermissions 체크 실행에 필요한 Kubernetes 권한 목록 (중복 제거, 정렬)
+// Gets에 있는 리소스는 get, 나머지 Resources는 list 권한이 필요하며,
+// watch가 true이면 감시 모드에서 인포머로 조회하는 모든 리소스에 list/watch 권한 추가
+func KubePermissions(selected []checks.Check, watch bool) []KubePermission {
+	type key struct{ group, resource, verb string }
+	perms := make(map[key][]string)
+	add := func(resource, verb, id string) {
+		name, group, _ := strings.Cut(resource, ".")
+		key := key{group: group, resource: name, verb: verb}
+		if id != "" {
+			perms[key] = appendUnique(perms[key], id)
+		} else if _, ok := perms[key]; !ok {
+			perms[key] = nil
+		}
+	}
+
+	for _, p := range baseKubePermissions {
+		add(joinResource(p.Resource, p.Group), p.Verb, "")
+	}
+	for _, check := range selected {
+		for _, resource := range check.Gets {
+			add(resource, "get", check.ID)
+		}
+		for _, resource := range check.Resources {
+			if watch && !check.RequiresAWS {
+				add(resource, "list", check.ID)
+				add(resource, "watch", check.ID)
+				continue
+			}
+			if !contains(check.Gets, resource) {
+				add(resource, "list", check.ID)
+			}
+		}
+	}
+
+	result := make([]KubePermission, 0, len(perms))
+	for k, ids := range perms {
+		result = append(result, KubePermission{Group: k.group, Resource: k.resource, Verb: k.verb, Checks: ids})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Resource != b.Resource {
+			return a.Resource < b.Resource
+		}
+		return a.Verb < b.Verb
+	})
+	return result
+}
+
+// AWSActions 체크 실행에 필요한 AWS IAM 액션 목록 (중복 제거, 정렬)
+// AWS API가 필요한 체크를 하나도 선택하지 않아도 클러스터 정보 조회용 액션은 포함
+func AWSActions(selected []checks.Check) []AWSAction {
+	actions := make(map[string][]string)
+	for _, action := range baseAWSActions {
+		actions[action] = nil
+	}
+	for _, check := range selected {
+		for _, action := range check.AWSActions {
+			actions[action] = appendUnique(actions[action], check.ID)
+		}
+	}
+
+	result := make([]AWSAction, 0, len(actions))
+	for action, ids := range actions {
+		result = append(result, AWSAction{Action: action, Checks: ids})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Action < result[j].Action })
+	return result
+}
+
+func joinResource(resource, group string) string {
+	if group == "" {
+		return resource
+	}
+	return resource + "." + group
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(list []string, value string) []string {
+	if contains(list, value) {
+		return list
+	}
+	return append(list, value)
+}
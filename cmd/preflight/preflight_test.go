@@ -0,0 +1,180 @@
+package preflight_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/preflight"
+	"eks-checklist/cmd/testutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func sorted(list []string) string {
+	list = append([]string{}, list...)
+	sort.Strings(list)
+	return strings.Join(list, ", ")
+}
+
+func TestPermissions(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "preflight_permissions.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			selected, err := checks.Select(nil, testutils.ToStrings(tc["check_ids"]))
+			if err != nil {
+				t.Fatalf("Test '%s' failed: %v", testName, err)
+			}
+
+			var kube []string
+			for _, p := range preflight.KubePermissions(selected, tc["watch"].(bool)) {
+				kube = append(kube, p.String())
+			}
+			if expect := testutils.ToStrings(tc["expect_kube"]); sorted(kube) != sorted(expect) {
+				t.Errorf("Test '%s' failed: expected kubernetes permissions [%s], got [%s]", testName, sorted(expect), sorted(kube))
+			}
+
+			var actions []string
+			for _, a := range preflight.AWSActions(selected) {
+				actions = append(actions, a.Action)
+			}
+			if expect := testutils.ToStrings(tc["expect_aws"]); sorted(actions) != sorted(expect) {
+				t.Errorf("Test '%s' failed: expected aws actions [%s], got [%s]", testName, sorted(expect), sorted(actions))
+			}
+		})
+	}
+}
+
+// TestPolicyFilesInSync 저장소의 배포용 정책 파일이 체크 코드에서 생성한 정책과 같은지 확인
+func TestPolicyFilesInSync(t *testing.T) {
+	policy, err := preflight.IAMPolicyJSON(preflight.IAMPolicy(preflight.AWSActions(checks.All)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := os.ReadFile("../../policy/minimum-policy.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(policy, current) {
+		t.Errorf("policy/minimum-policy.json이 최신이 아닙니다: 'eks-checklist preflight generate --kind iam'으로 다시 생성하세요")
+	}
+
+	role, err := preflight.ClusterRoleYAML(preflight.ClusterRole(preflight.DefaultRoleName, preflight.KubePermissions(checks.All, false)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	manifest, err := os.ReadFile("../../manifest/output-html-job.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(manifest, role) {
+		t.Errorf("manifest/output-html-job.yaml의 ClusterRole이 최신이 아닙니다: 'eks-checklist preflight generate --kind rbac'으로 다시 생성하세요")
+	}
+}
+
+func TestReviewKube(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		if attrs.Resource == "secrets" {
+			return true, nil, fmt.Errorf("api unavailable")
+		}
+		review.Status.Allowed = attrs.Verb == "list"
+		if !review.Status.Allowed {
+			review.Status.Reason = "no rbac rule"
+		}
+		return true, review, nil
+	})
+
+	perms := []preflight.KubePermission{
+		{Resource: "pods", Verb: "list", Checks: []string{"GEN-003"}},
+		{Resource: "nodes", Verb: "get", Checks: []string{"SEC-014"}},
+		{Resource: "secrets", Verb: "list", Checks: []string{"SEC-011"}},
+	}
+	findings := preflight.ReviewKube(context.Background(), client, perms)
+
+	expect := []string{preflight.StatusAllowed, preflight.StatusDenied, preflight.StatusUnknown}
+	for i, finding := range findings {
+		if finding.Status != expect[i] {
+			t.Errorf("%s: expected %s, got %s", finding.Permission, expect[i], finding.Status)
+		}
+	}
+	if missing := preflight.Missing(findings); len(missing) != 2 || missing[0].Checks[0] != "SEC-014" {
+		t.Errorf("expected 2 missing permissions starting with SEC-014, got %+v", missing)
+	}
+}
+
+type fakeSimulator struct {
+	allowed map[string]bool
+	err     error
+}
+
+func (f fakeSimulator) SimulatePrincipalPolicy(ctx context.Context, input *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	output := &iam.SimulatePrincipalPolicyOutput{}
+	for _, action := range input.ActionNames {
+		decision := iamtypes.PolicyEvaluationDecisionTypeImplicitDeny
+		if f.allowed[action] {
+			decision = iamtypes.PolicyEvaluationDecisionTypeAllowed
+		}
+		output.EvaluationResults = append(output.EvaluationResults, iamtypes.EvaluationResult{
+			EvalActionName: aws.String(action),
+			EvalDecision:   decision,
+		})
+	}
+	return output, nil
+}
+
+func TestReviewAWS(t *testing.T) {
+	actions := []preflight.AWSAction{{Action: "eks:DescribeCluster"}, {Action: "ec2:DescribeSubnets", Checks: []string{"NET-001"}}}
+
+	findings, err := preflight.ReviewAWS(context.Background(), fakeSimulator{allowed: map[string]bool{"eks:DescribeCluster": true}}, "arn:aws:iam::111122223333:role/checker", actions)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if findings[0].Status != preflight.StatusAllowed || findings[1].Status != preflight.StatusDenied || findings[1].Reason != "implicitDeny" {
+		t.Errorf("unexpected findings: %+v", findings)
+	}
+
+	findings, err = preflight.ReviewAWS(context.Background(), fakeSimulator{err: fmt.Errorf("AccessDenied")}, "arn:aws:iam::111122223333:role/checker", actions)
+	if err == nil {
+		t.Errorf("expected simulation error")
+	}
+	for _, finding := range findings {
+		if finding.Status != preflight.StatusUnknown {
+			t.Errorf("%s: expected unknown when simulation fails, got %s", finding.Permission, finding.Status)
+		}
+	}
+}
+
+func TestPrincipalARN(t *testing.T) {
+	cases := []struct{ caller, principal, role string }{
+		{"arn:aws:sts::111122223333:assumed-role/Admin/alice", "arn:aws:iam::111122223333:role/Admin", "Admin"},
+		{"arn:aws-cn:sts::111122223333:assumed-role/Checker/i-0abc", "arn:aws-cn:iam::111122223333:role/Checker", "Checker"},
+		{"arn:aws:iam::111122223333:user/alice", "arn:aws:iam::111122223333:user/alice", ""},
+		{"not-an-arn", "not-an-arn", ""},
+	}
+	for _, c := range cases {
+		principal, role := preflight.PrincipalARN(c.caller)
+		if principal != c.principal || role != c.role {
+			t.Errorf("%s: expected (%s, %s), got (%s, %s)", c.caller, c.principal, c.role, principal, role)
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 권한 확인 결과 상태
+const (
+	StatusAllowed = "allowed"
+	StatusDenied  = "denied"
+	// StatusUnknown 권한 확인 API 호출 자체가 실패해 허용 여부를 알 수 없음
+	StatusUnknown = "unknown"
+)
+
+// 권한 종류
+const (
+	KindKubernetes = "kubernetes"
+	KindAWS        = "aws"
+)
+
+// Finding 권한 한 건의 확인 결과
+type Finding struct {
+	Kind       string   `json:"kind"`
+	Permission string   `json:"permission"`
+	Status     string   `json:"status"`
+	Reason     string   `json:"reason,omitempty"`
+	Checks     []string `json:"checks,omitempty"`
+}
+
+// ReviewKube SelfSubjectAccessReview로 현재 사용자에게 각 권한이 있는지 확인 (전체 네임스페이스 기준)
+func ReviewKube(ctx context.Context, client kubernetes.Interface, perms []KubePermission) []Finding {
+	findings := make([]Finding, 0, len(perms))
+	for _, p := range perms {
+		finding := Finding{Kind: KindKubernetes, Permission: p.String(), Checks: p.Checks}
+
+		review, err := client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &authorizationv1.ResourceAttributes{
+					Group:    p.Group,
+					Resource: p.Resource,
+					Verb:     p.Verb,
+				},
+			},
+		}, metav1.CreateOptions{})
+		switch {
+		case err != nil:
+			finding.Status = StatusUnknown
+			finding.Reason = err.Error()
+		case review.Status.Allowed:
+			finding.Status = StatusAllowed
+		default:
+			finding.Status = StatusDenied
+			finding.Reason = review.Status.Reason
+		}
+		findings = append(findings, finding)
+	}
+	return findings
+}
+
+// ReviewAWS IAM SimulatePrincipalPolicy로 주체(IAM 역할/사용자)에게 각 액션이 허용되는지 확인
+// 시뮬레이션 자체가 실패하면(iam:SimulatePrincipalPolicy 권한 없음 등) 모든 액션을 확인 불가로 표시하고 오류 반환
+// SCP(서비스 제어 정책)는 시뮬레이션에 반영되지 않으므로 허용으로 표시되어도 실제 호출은 거부될 수 있음
+func ReviewAWS(ctx context.Context, client iam.SimulatePrincipalPolicyAPIClient, principalArn string, actions []AWSAction) ([]Finding, error) {
+	names := make([]string, 0, len(actions))
+	for _, action := range actions {
+		names = append(names, action.Action)
+	}
+
+	decisions := make(map[string]string)
+	paginator := iam.NewSimulatePrincipalPolicyPaginator(client, &iam.SimulatePrincipalPolicyInput{
+		PolicySourceArn: aws.String(principalArn),
+		ActionNames:     names,
+	})
+	var simulateErr error
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			simulateErr = fmt.Errorf("IAM 정책 시뮬레이션 실패 (%s): %w", principalArn, err)
+			break
+		}
+		for _, result := range page.EvaluationResults {
+			decisions[aws.ToString(result.EvalActionName)] = string(result.EvalDecision)
+		}
+	}
+
+	findings := make([]Finding, 0, len(actions))
+	for _, action := range actions {
+		finding := Finding{Kind: KindAWS, Permission: action.Action, Checks: action.Checks}
+		decision, ok := decisions[action.Action]
+		switch {
+		case simulateErr != nil || !ok:
+			finding.Status = StatusUnknown
+			finding.Reason = "시뮬레이션 결과 없음"
+		case decision == "allowed":
+			finding.Status = StatusAllowed
+		default:
+			finding.Status = StatusDenied
+			finding.Reason = decision
+		}
+		findings = append(findings, finding)
+	}
+	return findings, simulateErr
+}
+
+// CallerPrincipal 현재 AWS 자격 증명의 IAM 주체 ARN (SimulatePrincipalPolicy 대상)
+// AssumeRole 세션이면 역할 ARN으로 변환하며, 역할 경로(path)를 반영하기 위해 iam:GetRole을 시도
+func CallerPrincipal(ctx context.Context, cfg aws.Config) (string, error) {
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", fmt.Errorf("AWS 자격 증명 확인 실패: %w", err)
+	}
+
+	principal, roleName := PrincipalARN(aws.ToString(identity.Arn))
+	if roleName != "" {
+		if role, err := iam.NewFromConfig(cfg).GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)}); err == nil {
+			principal = aws.ToString(role.Role.Arn)
+		}
+	}
+	return principal, nil
+}
+
+// PrincipalARN GetCallerIdentity ARN을 IAM 정책 시뮬레이션에 사용할 주체 ARN으로 변환
+// arn:aws:sts::<계정>:assumed-role/<역할>/<세션> 형식이면 arn:aws:iam::<계정>:role/<역할>과 역할 이름 반환
+// (역할 경로는 알 수 없으므로 경로가 없는 ARN), 그 외 형식은 그대로 반환
+func PrincipalARN(callerArn string) (principal, roleName string) {
+	parts := strings.SplitN(callerArn, ":", 6)
+	if len(parts) != 6 || parts[2] != "sts" || !strings.HasPrefix(parts[5], "assumed-role/") {
+		return callerArn, ""
+	}
+	segments := strings.Split(parts[5], "/")
+	if len(segments) < 3 {
+		return callerArn, ""
+	}
+	roleName = segments[1]
+	return fmt.Sprintf("arn:%s:iam::%s:role/%s", parts[1], parts[4], roleName), roleName
+}
+
+// Missing 허용되지 않았거나 확인할 수 없는 권한만 반환
+func Missing(findings []Finding) []Finding {
+	var missing []Finding
+	for _, finding := range findings {
+		if finding.Status != StatusAllowed {
+			missing = append(missing, finding)
+		}
+	}
+	return missing
+}
@@ -3,20 +3,34 @@ package reliability
 import (
 	"context"
 	"fmt"
-	"os"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/autoscaling"
-	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
-// CheckAutoScaledManagedNodeGroup - ASG 기반 관리형 노드 그룹 자동 확장 여부 확인
-func CheckAutoScaledManagedNodeGroup(client kubernetes.Interface, clusterName string) common.CheckResult {
+// NodegroupAPI REL-011 점검에 사용하는 EKS API
+type NodegroupAPI interface {
+	DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
+}
+
+// AutoScalingAPI REL-011 점검에 사용하는 Auto Scaling API
+type AutoScalingAPI interface {
+	autoscaling.DescribeAutoScalingGroupsAPIClient
+}
+
+func CheckAutoScaledManagedNodeGroup(ctx context.Context, client kubernetes.Interface, cfg aws.Config, clusterName string) common.CheckResult {
+	return EvaluateAutoScaledManagedNodeGroup(ctx, client, eks.NewFromConfig(cfg), autoscaling.NewFromConfig(cfg), clusterName)
+}
+
+// EvaluateAutoScaledManagedNodeGroup - ASG 기반 관리형 노드 그룹 자동 확장 여부 확인
+func EvaluateAutoScaledManagedNodeGroup(ctx context.Context, client kubernetes.Interface, eksAPI NodegroupAPI, asgAPI AutoScalingAPI, clusterName string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-011] 오토스케일링 그룹 기반 관리형 노드 그룹 생성",
 		Manual:     false,
@@ -27,26 +41,7 @@ func CheckAutoScaledManagedNodeGroup(client kubernetes.Interface, clusterName st
 
 	result.Passed = false // 기본은 실패
 
-	awsProfile := os.Getenv("AWS_PROFILE")
-	region := os.Getenv("AWS_REGION")
-
-	sess, err := session.NewSessionWithOptions(session.Options{
-		Profile:           awsProfile,
-		SharedConfigState: session.SharedConfigEnable,
-		Config: aws.Config{
-			Region: aws.String(region),
-		},
-	})
-	if err != nil {
-		result.Passed = false
-		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
-		return result
-	}
-
-	eksClient := eks.New(sess)
-	asgClient := autoscaling.New(sess)
-
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{})
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = fmt.Sprintf("노드 목록 조회 실패: %v", err)
 		return result
@@ -71,23 +66,23 @@ func CheckAutoScaledManagedNodeGroup(client kubernetes.Interface, clusterName st
 	)
 
 	for nodeGroup := range managedNodeGroups {
-		ng, err := eksClient.DescribeNodegroup(&eks.DescribeNodegroupInput{
+		ng, err := eksAPI.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 			ClusterName:   aws.String(clusterName),
 			NodegroupName: aws.String(nodeGroup),
 		})
-		if err != nil {
+		if err != nil || ng.Nodegroup == nil {
 			nonAutoScaledNgs = append(nonAutoScaledNgs, nodeGroup+" (조회 실패)")
 			continue
 		}
 
-		if len(ng.Nodegroup.Resources.AutoScalingGroups) == 0 {
+		if ng.Nodegroup.Resources == nil || len(ng.Nodegroup.Resources.AutoScalingGroups) == 0 {
 			nonAutoScaledNgs = append(nonAutoScaledNgs, nodeGroup+" (ASG 없음)")
 			continue
 		}
 
-		asgName := ng.Nodegroup.Resources.AutoScalingGroups[0].Name
-		asg, err := asgClient.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
-			AutoScalingGroupNames: []*string{asgName},
+		asgName := aws.ToString(ng.Nodegroup.Resources.AutoScalingGroups[0].Name)
+		asg, err := asgAPI.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: []string{asgName},
 		})
 		if err != nil || len(asg.AutoScalingGroups) == 0 {
 			nonAutoScaledNgs = append(nonAutoScaledNgs, nodeGroup+" (ASG 조회 실패)")
@@ -99,7 +94,7 @@ func CheckAutoScaledManagedNodeGroup(client kubernetes.Interface, clusterName st
 			autoScaledCount++
 			result.Resources = append(result.Resources,
 				fmt.Sprintf("Nodegroup: %s | ASG: %s (minSize: %d, maxSize: %d)",
-					nodeGroup, *asgName, *asgConf.MinSize, *asgConf.MaxSize))
+					nodeGroup, asgName, *asgConf.MinSize, *asgConf.MaxSize))
 		} else {
 			nonAutoScaledNgs = append(nonAutoScaledNgs, nodeGroup+" (minSize ≥ maxSize)")
 		}
@@ -116,3 +111,37 @@ func CheckAutoScaledManagedNodeGroup(client kubernetes.Interface, clusterName st
 
 	return result
 }
+
+// CheckNodegroupScalingConfig 관리형 노드 그룹 구성(IaC 등)의 ScalingConfig로 자동 확장 가능 여부 확인
+func CheckNodegroupScalingConfig(nodegroups []ekstypes.Nodegroup) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[REL-011] 오토스케일링 그룹 기반 관리형 노드 그룹 생성",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "일부 관리형 노드 그룹이 ASG를 통한 자동 확장 구성이 되어 있지 않습니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-011",
+	}
+
+	if len(nodegroups) == 0 {
+		result.Passed = false
+		result.FailureMsg = "관리형 노드 그룹을 찾을 수 없습니다."
+		return result
+	}
+
+	for _, ng := range nodegroups {
+		name := aws.ToString(ng.NodegroupName)
+		sc := ng.ScalingConfig
+		if sc == nil || sc.MinSize == nil || sc.MaxSize == nil {
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Nodegroup: %s (scaling 설정 없음)", name))
+			continue
+		}
+		if *sc.MinSize >= *sc.MaxSize {
+			result.Passed = false
+			result.Resources = append(result.Resources,
+				fmt.Sprintf("Nodegroup: %s (minSize ≥ maxSize, minSize: %d, maxSize: %d)", name, *sc.MinSize, *sc.MaxSize))
+		}
+	}
+
+	return result
+}
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckProbe - 모든 Pod을 검색하여 startupProbe, livenessProbe, readinessProbe 가 모두 설정되었는지 확인
-func CheckProbe(client kubernetes.Interface) common.CheckResult {
+func CheckProbe(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-005] Probe(Startup, Readiness, Liveness) 적용",
 		Manual:     false,
@@ -20,7 +22,7 @@ func CheckProbe(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-005",
 	}
 
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -32,25 +34,34 @@ func CheckProbe(client kubernetes.Interface) common.CheckResult {
 			continue // kube-system은 검사 제외
 		}
 
-		for _, container := range pod.Spec.Containers {
-			var missing []string
-			if container.StartupProbe == nil {
-				missing = append(missing, "startupProbe")
-			}
-			if container.LivenessProbe == nil {
-				missing = append(missing, "livenessProbe")
-			}
-			if container.ReadinessProbe == nil {
-				missing = append(missing, "readinessProbe")
-			}
-
-			if len(missing) > 0 {
-				result.Passed = false
-				result.Resources = append(result.Resources,
-					fmt.Sprintf("Namespace: %s | Pod: %s | Container: %s (미설정: %v)", pod.Namespace, pod.Name, container.Name, missing))
-			}
+		for _, violation := range ProbeViolations(pod.Spec) {
+			result.Passed = false
+			result.Resources = append(result.Resources,
+				fmt.Sprintf("Namespace: %s | Pod: %s | %s", pod.Namespace, pod.Name, violation))
 		}
 	}
 
 	return result
 }
+
+// ProbeViolations PodSpec에서 startup/liveness/readiness probe가 누락된 컨테이너를 찾아 반환
+func ProbeViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	for _, container := range spec.Containers {
+		var missing []string
+		if container.StartupProbe == nil {
+			missing = append(missing, "startupProbe")
+		}
+		if container.LivenessProbe == nil {
+			missing = append(missing, "livenessProbe")
+		}
+		if container.ReadinessProbe == nil {
+			missing = append(missing, "readinessProbe")
+		}
+
+		if len(missing) > 0 {
+			violations = append(violations, fmt.Sprintf("Container: %s (미설정: %v)", container.Name, missing))
+		}
+	}
+	return violations
+}
@@ -114,7 +114,7 @@ func TestCheckProbe(t *testing.T) {
 			client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), &pod, v1.CreateOptions{})
 
 			// 함수 실행
-			result := reliability.CheckProbe(client)
+			result := reliability.CheckProbe(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
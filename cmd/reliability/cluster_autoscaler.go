@@ -6,13 +6,14 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckClusterAutoscalerEnabled checks whether the Cluster Autoscaler is deployed in the cluster.
-func CheckClusterAutoscalerEnabled(client kubernetes.Interface) common.CheckResult {
+func CheckClusterAutoscalerEnabled(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-012] Cluster Autoscaler 적용",
 		Manual:     false,
@@ -21,7 +22,7 @@ func CheckClusterAutoscalerEnabled(client kubernetes.Interface) common.CheckResu
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-012",
 	}
 
-	deployments, err := client.AppsV1().Deployments("").List(context.TODO(), v1.ListOptions{})
+	deployments, err := kube.ListAll(ctx, client.AppsV1().Deployments("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -86,7 +86,7 @@ func TestCheckClusterAutoscalerEnabled(t *testing.T) {
 			}
 
 			// CheckClusterAutoscalerEnabled 함수 실행 및 반환값 검증
-			result := reliability.CheckClusterAutoscalerEnabled(client)
+			result := reliability.CheckClusterAutoscalerEnabled(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
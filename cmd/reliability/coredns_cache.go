@@ -11,7 +11,7 @@ import (
 )
 
 // CheckCoreDNSCache checks whether the "cache" plugin is enabled in the CoreDNS Corefile.
-func CheckCoreDNSCache(client kubernetes.Interface) common.CheckResult {
+func CheckCoreDNSCache(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[REL-017] DNS 캐시 적용",
 		Manual:    false,
@@ -19,7 +19,7 @@ func CheckCoreDNSCache(client kubernetes.Interface) common.CheckResult {
 		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-017",
 	}
 
-	configMap, err := client.CoreV1().ConfigMaps("kube-system").Get(context.TODO(), "coredns", v1.GetOptions{})
+	configMap, err := client.CoreV1().ConfigMaps("kube-system").Get(ctx, "coredns", v1.GetOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -55,7 +55,7 @@ func TestCheckCoreDNSCache(t *testing.T) {
 				// ConfigMap을 생성하지 않음
 			}
 
-			result := reliability.CheckCoreDNSCache(client)
+			result := reliability.CheckCoreDNSCache(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, expectPass, result.Passed)
@@ -4,13 +4,14 @@ import (
 	"context"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckCoreDNSHpa checks if CoreDNS has an HPA set in the kube-system namespace.
-func CheckCoreDNSHpa(client kubernetes.Interface) common.CheckResult {
+func CheckCoreDNSHpa(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-016] CoreDNS에 HPA 적용",
 		Manual:     false,
@@ -19,7 +20,7 @@ func CheckCoreDNSHpa(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-016",
 	}
 
-	hpas, err := client.AutoscalingV1().HorizontalPodAutoscalers("kube-system").List(context.TODO(), v1.ListOptions{
+	hpas, err := kube.ListAll(ctx, client.AutoscalingV1().HorizontalPodAutoscalers("kube-system").List, v1.ListOptions{
 		FieldSelector: "metadata.name=coredns",
 	})
 	if err != nil {
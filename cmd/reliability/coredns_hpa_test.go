@@ -41,7 +41,7 @@ func TestCheckCoreDNSHpa(t *testing.T) {
 				}
 			}
 
-			result := reliability.CheckCoreDNSHpa(client)
+			result := reliability.CheckCoreDNSHpa(context.Background(), client)
 
 			if result.Passed != !expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, !expectPass, result.Passed)
@@ -76,7 +76,7 @@ func TestCheckDaemonSetPriorityClass(t *testing.T) {
 
 			// 첫 번째 인자로 Karpenter 설치 여부를 나타내는 CheckResult 전달
 			karpenterCheck := common.CheckResult{Passed: karpenterInstalled}
-			result := reliability.CheckDaemonSetPriorityClass(karpenterCheck, client)
+			result := reliability.CheckDaemonSetPriorityClass(context.Background(), karpenterCheck, client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
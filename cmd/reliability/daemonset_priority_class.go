@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckDaemonSetPriorityClass checks if all DaemonSets have a PriorityClass assigned.
-func CheckDaemonSetPriorityClass(karpenter_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
+func CheckDaemonSetPriorityClass(ctx context.Context, karpenter_installed common.CheckResult, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-018] Karpenter 사용시 DaemonSet에 Priority Class 부여",
 		Manual:     false,
@@ -26,7 +27,7 @@ func CheckDaemonSetPriorityClass(karpenter_installed common.CheckResult, client
 		return result
 	}
 
-	daemonSets, err := client.AppsV1().DaemonSets("").List(context.TODO(), v1.ListOptions{})
+	daemonSets, err := kube.ListAll(ctx, client.AppsV1().DaemonSets("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckPodDistributionAndAffinity checks whether pods are evenly distributed via affinity or topologySpreadConstraints.
-func CheckPodDistributionAndAffinity(clientset kubernetes.Interface) common.CheckResult {
+func CheckPodDistributionAndAffinity(ctx context.Context, clientset kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-003] 동일한 역할을 하는 Pod를 다수의 노드에 분산 배포",
 		Manual:     false,
@@ -20,7 +22,7 @@ func CheckPodDistributionAndAffinity(clientset kubernetes.Interface) common.Chec
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-003",
 	}
 
-	pods, err := clientset.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, clientset.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -28,23 +30,9 @@ func CheckPodDistributionAndAffinity(clientset kubernetes.Interface) common.Chec
 	}
 
 	for _, pod := range pods.Items {
-		affinityExists := pod.Spec.Affinity != nil
-		topologyValid := false
-
-		if len(pod.Spec.TopologySpreadConstraints) > 0 {
-			topologyValid = true
-			for _, constraint := range pod.Spec.TopologySpreadConstraints {
-				if constraint.MaxSkew > 1 {
-					topologyValid = false
-					result.Resources = append(result.Resources,
-						fmt.Sprintf("Namespace: %s | Pod: %s - maxSkew 값이 %d (1 초과)", pod.Namespace, pod.Name, constraint.MaxSkew))
-				}
-			}
-		}
-
-		if !affinityExists && !topologyValid {
+		for _, violation := range DistributionViolations(pod.Spec) {
 			result.Resources = append(result.Resources,
-				fmt.Sprintf("Namespace: %s | Pod: %s - affinity와 유효한 topologySpreadConstraints 설정이 모두 없음", pod.Namespace, pod.Name))
+				fmt.Sprintf("Namespace: %s | Pod: %s - %s", pod.Namespace, pod.Name, violation))
 		}
 	}
 
@@ -56,3 +44,26 @@ func CheckPodDistributionAndAffinity(clientset kubernetes.Interface) common.Chec
 
 	return result
 }
+
+// DistributionViolations PodSpec의 affinity와 topologySpreadConstraints 설정 문제를 찾아 반환
+func DistributionViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	affinityExists := spec.Affinity != nil
+	topologyValid := false
+
+	if len(spec.TopologySpreadConstraints) > 0 {
+		topologyValid = true
+		for _, constraint := range spec.TopologySpreadConstraints {
+			if constraint.MaxSkew > 1 {
+				topologyValid = false
+				violations = append(violations, fmt.Sprintf("maxSkew 값이 %d (1 초과)", constraint.MaxSkew))
+			}
+		}
+	}
+
+	if !affinityExists && !topologyValid {
+		violations = append(violations, "affinity와 유효한 topologySpreadConstraints 설정이 모두 없음")
+	}
+
+	return violations
+}
@@ -90,7 +90,7 @@ func TestCheckPodDistributionAndAffinity(t *testing.T) {
 				}
 			}
 
-			result := reliability.CheckPodDistributionAndAffinity(client)
+			result := reliability.CheckPodDistributionAndAffinity(context.Background(), client)
 
 			// 기대값에 따라 "PASS" 또는 "FAIL" 문자열이 출력되었는지 확인
 			if result.Passed != expectPass {
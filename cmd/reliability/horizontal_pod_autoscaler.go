@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckHpa checks whether Deployments are using Horizontal Pod Autoscaler (HPA).
-func CheckHpa(client kubernetes.Interface) common.CheckResult {
+func CheckHpa(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-004] HPA 적용",
 		Manual:     false,
@@ -21,7 +22,7 @@ func CheckHpa(client kubernetes.Interface) common.CheckResult {
 	}
 
 	// 모든 Deployment 조회
-	deployments, err := client.AppsV1().Deployments(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	deployments, err := kube.ListAll(ctx, client.AppsV1().Deployments(metav1.NamespaceAll).List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -29,7 +30,7 @@ func CheckHpa(client kubernetes.Interface) common.CheckResult {
 	}
 
 	// 모든 HPA 조회
-	hpas, err := client.AutoscalingV1().HorizontalPodAutoscalers(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{})
+	hpas, err := kube.ListAll(ctx, client.AutoscalingV1().HorizontalPodAutoscalers(metav1.NamespaceAll).List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -96,7 +96,7 @@ func TestCheckHpa(t *testing.T) {
 			}
 
 			// 함수 실행 및 결과 비교
-			result := reliability.CheckHpa(client)
+			result := reliability.CheckHpa(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v\nFailureMsg: %s\nResources: %v", testName, expectPass, result.Passed, result.FailureMsg, result.Resources)
 			} else {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -11,7 +12,7 @@ import (
 )
 
 // CheckKarpenterNode checks whether there are any Karpenter NodeClaims provisioned in the cluster.
-func CheckKarpenterNode(karpenter_installed common.CheckResult, client dynamic.Interface) common.CheckResult {
+func CheckKarpenterNode(ctx context.Context, karpenter_installed common.CheckResult, client dynamic.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-013] Karpenter 기반 노드 생성",
 		Manual:     false,
@@ -33,7 +34,7 @@ func CheckKarpenterNode(karpenter_installed common.CheckResult, client dynamic.I
 		Resource: "nodeclaims",
 	}
 
-	nodeClaims, err := client.Resource(nodeClaimGVR).List(context.TODO(), v1.ListOptions{})
+	nodeClaims, err := kube.ListAll(ctx, client.Resource(nodeClaimGVR).List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -1,6 +1,7 @@
 package reliability_test
 
 import (
+	"context"
 	"testing"
 
 	"eks-checklist/cmd/common"
@@ -17,10 +18,10 @@ func TestCheckKarpenterNode(t *testing.T) {
 	// YAML 파일 "karpenter_node.yaml"에서 테스트 케이스 로드
 	testCases := testutils.LoadTestCases(t, "karpenter_node.yaml")
 
-	// NodeClaim GVR 정의 (Karpenter v1beta1 기준)
+	// NodeClaim GVR 정의 (Karpenter v1 기준)
 	gvr := schema.GroupVersionResource{
 		Group:    "karpenter.k8s.aws",
-		Version:  "v1beta1",
+		Version:  "v1",
 		Resource: "nodeclaims",
 	}
 	// GVR에 대응되는 ListKind 등록
@@ -51,7 +52,7 @@ func TestCheckKarpenterNode(t *testing.T) {
 			if nodeClaimPresent {
 				nodeClaim := &unstructured.Unstructured{
 					Object: map[string]interface{}{
-						"apiVersion": "karpenter.k8s.aws/v1beta1",
+						"apiVersion": "karpenter.k8s.aws/v1",
 						"kind":       "NodeClaim",
 						"metadata": map[string]interface{}{
 							"name": "test-nodeclaim",
@@ -68,7 +69,7 @@ func TestCheckKarpenterNode(t *testing.T) {
 			karpenterCheck := common.CheckResult{Passed: karpenterInstalled}
 
 			// 함수 호출: 두 인자(karpenterCheck, client) 전달
-			result := reliability.CheckKarpenterNode(karpenterCheck, client)
+			result := reliability.CheckKarpenterNode(context.Background(), karpenterCheck, client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
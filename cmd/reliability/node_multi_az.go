@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckNodeMultiAZ - 데이터 플레인 노드가 여러 가용영역(AZ)에 분산 배포되어 있는지 확인
-func CheckNodeMultiAZ(client kubernetes.Interface) common.CheckResult {
+func CheckNodeMultiAZ(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-014] 다수의 가용 영역에 데이터 플레인 노드 배포",
 		Manual:     false,
@@ -20,7 +21,7 @@ func CheckNodeMultiAZ(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-014",
 	}
 
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{})
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
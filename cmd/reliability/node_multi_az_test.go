@@ -70,7 +70,7 @@ func TestCheckNodeMultiAZ(t *testing.T) {
 				}, metav1.CreateOptions{})
 			}
 
-			result := reliability.CheckNodeMultiAZ(client)
+			result := reliability.CheckNodeMultiAZ(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
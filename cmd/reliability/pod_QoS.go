@@ -9,11 +9,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckQoSClass(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckQoSClass(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-008] 애플리케이션 중요도에 따른 QoS 적용 - Manual",
 		Manual:     true,
@@ -22,14 +23,12 @@ func CheckQoSClass(client kubernetes.Interface, cfg aws.Config, eksCluster strin
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-008",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-qos-class")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
-	ctx := context.TODO()
-	pods, err := client.CoreV1().Pods("").List(ctx, v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "Pod 목록 조회 실패: " + err.Error()
 		return result
@@ -10,11 +10,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckVolumeAffinity(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckVolumeAffinity(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-015] PV 사용시 volume affinity 위반 사항 체크",
 		Manual:     true,
@@ -23,10 +24,8 @@ func CheckVolumeAffinity(client kubernetes.Interface, cfg aws.Config, eksCluster
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-015",
 	}
 
-	ctx := context.TODO()
-
 	// 1. PVC 목록
-	pvcList, err := client.CoreV1().PersistentVolumeClaims("").List(ctx, v1.ListOptions{})
+	pvcList, err := kube.ListAll(ctx, client.CoreV1().PersistentVolumeClaims("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "PVC 목록 조회 실패: " + err.Error()
 		return result
@@ -38,7 +37,6 @@ func CheckVolumeAffinity(client kubernetes.Interface, cfg aws.Config, eksCluster
 		return result
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-volume-affinity")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
@@ -46,14 +44,14 @@ func CheckVolumeAffinity(client kubernetes.Interface, cfg aws.Config, eksCluster
 
 	// 2. PV 목록 (Map 형태로 구성)
 	pvMap := map[string]corev1.PersistentVolume{}
-	if pvList, err := client.CoreV1().PersistentVolumes().List(ctx, v1.ListOptions{}); err == nil {
+	if pvList, err := kube.ListAll(ctx, client.CoreV1().PersistentVolumes().List, v1.ListOptions{}); err == nil {
 		for _, pv := range pvList.Items {
 			pvMap[pv.Name] = pv
 		}
 	}
 
 	// 3. Pod 목록
-	podList, _ := client.CoreV1().Pods("").List(ctx, v1.ListOptions{})
+	podList, _ := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 
 	type VolumeAffinityInfo struct {
 		Namespace    string                     `json:"namespace"`
@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // PodReplicaSetCheck checks that ReplicaSets are configured with more than 1 pod (replica).
-func PodReplicaSetCheck(client kubernetes.Interface) common.CheckResult {
+func PodReplicaSetCheck(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-002] 2개 이상의 Pod 복제본 사용",
 		Manual:     false,
@@ -20,7 +21,7 @@ func PodReplicaSetCheck(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-002",
 	}
 
-	replicaSets, err := client.AppsV1().ReplicaSets("").List(context.TODO(), v1.ListOptions{})
+	replicaSets, err := kube.ListAll(ctx, client.AppsV1().ReplicaSets("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
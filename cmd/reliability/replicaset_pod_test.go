@@ -71,7 +71,7 @@ func TestPodReplicaSetCheck(t *testing.T) {
 				}
 			}
 
-			result := reliability.PodReplicaSetCheck(client)
+			result := reliability.PodReplicaSetCheck(context.Background(), client)
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
 			}
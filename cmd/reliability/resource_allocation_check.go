@@ -2,20 +2,23 @@ package reliability
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"gopkg.in/yaml.v3"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckResourceAllocation(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckResourceAllocation(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-007] 애플리케이션에 적절한 CPU/RAM 할당",
 		Manual:     true,
@@ -24,33 +27,25 @@ func CheckResourceAllocation(client kubernetes.Interface, cfg aws.Config, eksClu
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-007",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-resource-allocation")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
 	yamlDir := filepath.Join(baseDir, "yamls")
-	ctx := context.TODO()
-	podList, err := client.CoreV1().Pods("").List(ctx, v1.ListOptions{})
+	podList, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "Pod 목록 조회 실패: " + err.Error()
 		return result
 	}
 
-	type ResourceInfo struct {
-		Namespace     string `json:"namespace"`
-		Pod           string `json:"pod"`
-		Container     string `json:"container"`
-		HasRequest    bool   `json:"hasRequest"`
-		HasLimit      bool   `json:"hasLimit"`
-		RequestCPU    string `json:"requestCPU,omitempty"`
-		RequestMemory string `json:"requestMemory,omitempty"`
-		LimitCPU      string `json:"limitCPU,omitempty"`
-		LimitMemory   string `json:"limitMemory,omitempty"`
+	type IncompletePod struct {
+		Namespace  string   `json:"namespace"`
+		Pod        string   `json:"pod"`
+		Violations []string `json:"violations"`
 	}
 
-	var incomplete []ResourceInfo
+	var incomplete []IncompletePod
 	ExistSetting := false
 
 	for _, pod := range podList.Items {
@@ -58,36 +53,17 @@ func CheckResourceAllocation(client kubernetes.Interface, cfg aws.Config, eksClu
 			continue
 		}
 
+		// 미설정 판정은 웹훅(REL-007)과 같은 기준을 사용
+		if violations := ResourceAllocationViolations(pod.Spec); len(violations) > 0 {
+			incomplete = append(incomplete, IncompletePod{
+				Namespace:  pod.Namespace,
+				Pod:        pod.Name,
+				Violations: violations,
+			})
+		}
+
 		for _, container := range pod.Spec.Containers {
 			res := container.Resources
-			info := ResourceInfo{
-				Namespace: pod.Namespace,
-				Pod:       pod.Name,
-				Container: container.Name,
-			}
-
-			if res.Requests != nil {
-				info.HasRequest = true
-				if cpu := res.Requests.Cpu(); cpu != nil {
-					info.RequestCPU = cpu.String()
-				}
-				if mem := res.Requests.Memory(); mem != nil {
-					info.RequestMemory = mem.String()
-				}
-			}
-			if res.Limits != nil {
-				info.HasLimit = true
-				if cpu := res.Limits.Cpu(); cpu != nil {
-					info.LimitCPU = cpu.String()
-				}
-				if mem := res.Limits.Memory(); mem != nil {
-					info.LimitMemory = mem.String()
-				}
-			}
-
-			if !info.HasRequest || !info.HasLimit {
-				incomplete = append(incomplete, info)
-			}
 
 			// request, limits 설정이 되어 있는 Pod 일 경우, 설정값을 YAML 파일로 저장
 			if res.Requests != nil && res.Limits != nil {
@@ -135,3 +111,22 @@ func CheckResourceAllocation(client kubernetes.Interface, cfg aws.Config, eksClu
 
 	return result
 }
+
+// ResourceAllocationViolations PodSpec에서 Request 또는 Limit이 설정되지 않은 컨테이너를 찾아 반환
+func ResourceAllocationViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	for _, container := range spec.Containers {
+		var missing []string
+		if container.Resources.Requests == nil {
+			missing = append(missing, "requests")
+		}
+		if container.Resources.Limits == nil {
+			missing = append(missing, "limits")
+		}
+
+		if len(missing) > 0 {
+			violations = append(violations, fmt.Sprintf("Container: %s (미설정: %v)", container.Name, missing))
+		}
+	}
+	return violations
+}
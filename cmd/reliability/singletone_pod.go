@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // SingletonPodCheck checks for standalone pods that are not managed by a controller (Deployment, StatefulSet, etc.).
-func SingletonPodCheck(client kubernetes.Interface) common.CheckResult {
+func SingletonPodCheck(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[REL-001] 싱글톤 Pod 미사용",
 		Manual:     false,
@@ -20,7 +21,7 @@ func SingletonPodCheck(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-001",
 	}
 
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -69,7 +69,7 @@ func TestSingletonPodCheck(t *testing.T) {
 				// intentionally empty — no problematic objects created
 			}
 
-			result := reliability.SingletonPodCheck(client)
+			result := reliability.SingletonPodCheck(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, expectPass, result.Passed)
@@ -1,29 +1,57 @@
 package cmd
 
 import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"eks-checklist/cmd/artifacts"
+	"eks-checklist/cmd/bundle"
+	"eks-checklist/cmd/checks"
 	"eks-checklist/cmd/common"
-	"eks-checklist/cmd/cost"
-	"eks-checklist/cmd/general"
-	"eks-checklist/cmd/network"
-	"eks-checklist/cmd/reliability"
-	"eks-checklist/cmd/scalability"
-	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/kube"
+	"eks-checklist/cmd/tui"
+	"eks-checklist/pkg/checklist"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"slices"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"k8s.io/client-go/util/homedir"
 )
 
 var (
-	kubeconfigPath    string
-	kubeconfigContext string
-	awsProfile        string
-	outputFilter      string
-	outputFormat      string
-	sortMode          bool
+	kubeconfigPath        string
+	kubeconfigContext     string
+	awsProfile            string
+	outputFilter          string
+	outputFormat          string
+	sortMode              bool
+	tuiMode               bool
+	watchMode             bool
+	watchFormat           string
+	watchDebounce         time.Duration
+	watchAWSInterval      time.Duration
+	runTimeout            time.Duration
+	kubeQPS               float32
+	kubeBurst             int
+	checkTimeout          time.Duration
+	inlineRunbook         bool
+	templatePath          string
+	pdfEngine             string
+	outputDir             string
+	outputName            string
+	bundlePath            string
+	bundleKeyPath         string
+	bundleKey             ed25519.PrivateKey
+	clusterAdminAllowlist []string
+	restrictSGEgress      bool
 )
 
 var rootCmd = &cobra.Command{
@@ -31,254 +59,347 @@ var rootCmd = &cobra.Command{
 	Short: "eks-checklist",
 	Long:  "eks-checklist",
 	Run: func(cmd *cobra.Command, args []string) {
-		common.SetSortMode(sortMode)
-
-		if outputFilter != "" {
-			// 소문자로 변환하여 비교
-			lowerFilter := strings.ToLower(outputFilter)
-			validFilters := []string{"all", "pass", "fail", "manual"}
-			isValid := false
-
-			for _, valid := range validFilters {
-				if lowerFilter == valid {
-					isValid = true
-					break
-				}
-			}
-
-			if !isValid {
-				fmt.Printf("오류: 유효하지 않은 출력 필터 '%s'\n", outputFilter)
-				fmt.Println("유효한 값: all, pass, fail, manual")
-				os.Exit(1)
-			}
+		configureOutput()
 
-			fmt.Printf("Output filter: %s\n", lowerFilter)
-			common.SetOutputFilter(lowerFilter)
+		// 감시 모드는 종료될 때까지 계속 실행되므로 전체 실행 시간 제한과 함께 사용할 수 없음
+		if watchMode && runTimeout > 0 {
+			fmt.Println("오류: --watch와 --timeout은 함께 사용할 수 없습니다 (감시 모드는 Ctrl+C로 종료, 체크별 시간 제한은 --check-timeout 사용)")
+			os.Exit(1)
 		}
 
-		// 출력 형식 설정
-		if outputFormat != "" {
-			lowerFormat := strings.ToLower(outputFormat)
-			validFormats := []string{"text", "html", "pdf"}
-			isValid := false
-
-			for _, valid := range validFormats {
-				if lowerFormat == valid {
-					isValid = true
-					break
-				}
-			}
-
-			if !isValid {
-				fmt.Printf("오류: 유효하지 않은 출력 형식 '%s'\n", outputFormat)
-				fmt.Println("유효한 값: text, html, pdf")
-				os.Exit(1)
-			}
-
-			common.SetOutputFormat(lowerFormat)
+		// --timeout은 클러스터 선택 이후 API 호출과 모든 체크 실행에 적용
+		ctx := cmd.Context()
+		if runTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, runTimeout)
+			defer cancel()
 		}
 
-		// HTML 출력 초기화
-		if outputFormat == "html" || outputFormat == "pdf" {
-			common.InitHTMLOutput()
+		AWS_PROFILE, kubeconfig, err := getKubeconfig(kubeconfigPath, kubeconfigContext, awsProfile)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
 		}
-
-		AWS_PROFILE, kubeconfig := getKubeconfig(kubeconfigPath, kubeconfigContext, awsProfile)
-		cfg := GetAWSConfig(AWS_PROFILE)
-		cluster := getEksClusterName(kubeconfig, cfg)
+		kube.ConfigureRateLimit(&kubeconfig, kubeQPS, kubeBurst)
+		cfg, err := GetAWSConfig(AWS_PROFILE)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+		cluster := getEksClusterName(ctx, kubeconfig, cfg)
 
 		fmt.Printf("Running checks on %s\n", cluster)
 
-		k8sClient := createK8sClient(kubeconfig)
+		k8sClient, err := createK8sClient(kubeconfig)
+		if err != nil {
+			fmt.Println("Error creating kubernetes client:", err)
+			os.Exit(1)
+		}
 
-		eksCluster := Describe(cluster, cfg)
+		eksCluster, err := Describe(ctx, cluster, cfg)
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
 		dynamicClient, err := CreateDynamicClient(&kubeconfig)
 		if err != nil {
 			fmt.Println("Error creating dynamic client:", err)
 			os.Exit(1)
 		}
+		metadataClient, err := CreateMetadataClient(&kubeconfig)
+		if err != nil {
+			fmt.Println("Error creating metadata client:", err)
+			os.Exit(1)
+		}
 
-		// General 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("General Check")
-
-		// 코드형 인프라 (EKS 클러스터, 애플리케이션 배포)
-		common.PrintResult(general.CheckIAC())
-
-		// GitOps 적용
-		common.PrintResult(general.CheckGitOps())
-
-		// 컨테이너 이미지 태그에 latest 미사용
-		common.PrintResult(general.CheckImageTag(k8sClient))
-
-		// Security 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("Security Check")
-
-		// EKS 클러스터 API 엔드포인트 접근 제어(공인망, 사설망, IP 기반 제어) - Automatic
-		common.PrintResult(security.CheckEndpointPublicAccess(security.EksCluster(eksCluster)))
-
-		// 클러스터 접근 제어(Access entries, aws-auth 컨피그맵) - Automatic/Manual
-		common.PrintResult(security.CheckAccessControl(k8sClient, cfg, cluster))
-
-		// IRSA 또는 Pod Identity 기반 권한 부여 - Automatic
-		common.PrintResult(security.CheckIRSAAndPodIdentity(k8sClient))
-
-		// 데이터 플레인 노드에 필수로 필요한 IAM 권한만 부여 - Automatic
-		common.PrintResult(security.CheckNodeIAMRoles(k8sClient))
-
-		// 루트 유저가 아닌 유저로 컨테이너 실행 - Automatic
-		common.PrintResult(security.CheckContainerExecutionUser(k8sClient))
-
-		// 멀티 태넌시 적용 유무 - Manual
-		common.PrintResult(security.CheckMultitenancy(k8sClient, cfg, cluster))
-
-		// Audit 로그 활성화 - Automatic
-		common.PrintResult(security.CheckAuditLoggingEnabled(&security.EksCluster{Cluster: eksCluster.Cluster}))
-
-		// 비정상 접근에 대한 알림 설정 - Manual
-		common.PrintResult(security.CheckAccessAlarm())
-
-		// Pod-to-Pod 접근 제어 - Automatic/Manual
-		common.PrintResult(security.CheckPodToPodNetworkPolicy(k8sClient, cluster))
-
-		// PV 암호화 - Automatic
-		common.PrintResult(security.CheckPVEcryption(k8sClient))
-
-		// Secret 객체 암호화 - Automatic
-		common.PrintResult(security.CheckSecretEncryption(k8sClient))
-
-		// 데이터 플레인 사설망 - Automatic
-		common.PrintResult(security.DataplanePrivateCheck(security.EksCluster(eksCluster), cfg))
-
-		// 컨테이너 이미지 정적 분석 - Manual
-		common.PrintResult(security.CheckImageStaticAnalysis(k8sClient, cfg, cluster))
-
-		// 읽기 전용 파일시스템 사용 - Automatic
-		common.PrintResult(security.ReadnonlyFilesystemCheck(k8sClient))
-
-		// Scalability 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("Scalability Check")
-
-		// Karpenter 사용 - Automatic
-		common.PrintResult(scalability.GetKarpenter(k8sClient))
-
-		// Karpenter 전용 노드 그룹 혹은 Fargate 사용 - Automatic
-		common.PrintResult(scalability.CheckNodeGroupUsage(k8sClient))
-
-		// Spot 노드 사용시 Spot 중지 핸들러 적용 - Automatic
-		common.PrintResult(scalability.CheckSpotNodeTerminationHandler(k8sClient))
-
-		// 중요 Pod에 노드 삭제 방지용 Label 부여 - Manual
-		common.PrintResult(scalability.CheckImportantPodProtection(k8sClient, cfg, cluster))
-
-		// Application에 Graceful shutdown 적용 - Manual
-		common.PrintResult(scalability.CheckGracefulShutdown(k8sClient, cfg, cluster))
-
-		// 노드 확장/축소 정책 적용 - Manual
-		common.PrintResult(scalability.CheckNodeScalingPolicy())
-
-		// 다양한 인스턴스 타입 사용 - Automatic
-		common.PrintResult(scalability.CheckInstanceTypes(k8sClient))
-
-		// Scalability 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("Stability Check")
-
-		// 싱글톤 Pod 미사용 - Automatic
-		common.PrintResult(reliability.SingletonPodCheck(k8sClient))
-
-		// 2개 이상의 Pod 복제본 사용 - Automatic
-		common.PrintResult(reliability.PodReplicaSetCheck(k8sClient))
-
-		// 동일한 역할을 하는 Pod를 다수의 노드에 분산 배포 - Automatic
-		common.PrintResult(reliability.CheckPodDistributionAndAffinity(k8sClient))
-
-		// HPA 적용 - Automatic
-		common.PrintResult(reliability.CheckHpa(k8sClient))
-
-		// Probe(Startup, Readiness, Liveness) 적용 - Automatic
-		common.PrintResult(reliability.CheckProbe(k8sClient))
+		layout := newRunLayout(artifacts.ClusterVars(cluster, aws.ToString(eksCluster.Cluster.Arn), cfg.Region, time.Now()))
+
+		if watchMode {
+			runWatch(ctx, &checks.Env{
+				Client:                      k8sClient,
+				DynamicClient:               dynamicClient,
+				MetadataClient:              metadataClient,
+				AWSConfig:                   cfg,
+				Cluster:                     eksCluster.Cluster,
+				ClusterName:                 cluster,
+				EvidenceDir:                 layout.EvidenceDir(),
+				ClusterAdminAllowlist:       clusterAdminAllowlist,
+				RestrictSecurityGroupEgress: restrictSGEgress,
+			})
+			return
+		}
 
-		// 중요 워크로드에 대한 PDB(Pod Distruption Budget) 적용 - Automatic/Manual
-		common.PrintResult(reliability.CheckPDB())
+		opts := checklist.Options{
+			Client:                      k8sClient,
+			DynamicClient:               dynamicClient,
+			MetadataClient:              metadataClient,
+			AWSConfig:                   &cfg,
+			Cluster:                     eksCluster.Cluster,
+			ClusterName:                 cluster,
+			EvidenceDir:                 layout.EvidenceDir(),
+			CheckTimeout:                checkTimeout,
+			ClusterAdminAllowlist:       clusterAdminAllowlist,
+			RestrictSecurityGroupEgress: restrictSGEgress,
+		}
 
-		// 애플리케이션에 적절한 CPU/RAM 할당 - Automatic/Manual
-		common.PrintResult(reliability.CheckResourceAllocation(k8sClient, cfg, cluster))
+		if tuiMode {
+			runTUI(cmd, ctx, opts, layout)
+			return
+		}
 
-		// 애플리케이션 중요도에 따른 QoS 적용 - Automatic/Manual
-		common.PrintResult(reliability.CheckQoSClass(k8sClient, cfg, cluster))
+		// 체크 항목은 checks 패키지에 카테고리 순서대로 등록되어 있음
+		currentCategory := ""
+		opts.OnResult = func(r checklist.Result) {
+			if r.Category != currentCategory {
+				currentCategory = r.Category
+				common.PrintCategoryHeader(currentCategory)
+			}
+			common.PrintResult(r.CheckResult())
+		}
+		report, err := checklist.Run(ctx, opts)
+		if report == nil {
+			fmt.Println("체크 실행 오류:", err)
+			os.Exit(1)
+		}
 
-		// 인프라 및 애플리케이션 모니터링 스택 적용 - Manual
-		common.PrintResult(reliability.CheckNodeScalingPolicy())
+		// 요약본 (시간 초과 또는 취소로 중단된 경우에도 실행한 체크까지의 부분 보고서 출력)
+		common.PrintSummary()
+		finishRun(cmd, layout, reportResults(report))
+		if err != nil {
+			printIncomplete(report, err)
+			os.Exit(1)
+		}
+	},
+}
 
-		// 반영구 저장소에 애플리케이션 로그 저장 - Manual
-		common.PrintResult(reliability.CheckApplicationLogs())
+// printIncomplete 전체 실행 시간 초과 또는 취소로 실행하지 못한 체크 안내
+func printIncomplete(report *checklist.Report, err error) {
+	reason := "사용자 취소"
+	if errors.Is(err, context.DeadlineExceeded) {
+		reason = fmt.Sprintf("전체 실행 시간(--timeout %s) 초과", runTimeout)
+	}
+	fmt.Printf(common.Yellow+"⚠ 점검이 중단되었습니다 (%s). 부분 보고서입니다."+common.Reset+"\n", reason)
+	if report.Incomplete() {
+		fmt.Printf("  └─ 실행하지 못한 체크 %d개: %s\n", len(report.NotRun), strings.Join(report.NotRun, ", "))
+	}
+}
 
-		// 오토스케일링 그룹 기반 관리형 노드 그룹 생성 - Automatic
-		common.PrintResult(reliability.CheckAutoScaledManagedNodeGroup(k8sClient, cluster))
+// runTUI 모든 체크를 실행한 뒤 결과를 대화형 TUI로 표시
+func runTUI(cmd *cobra.Command, ctx context.Context, opts checklist.Options, layout *artifacts.Layout) {
+	opts.OnResult = func(r checklist.Result) {
+		fmt.Printf("점검 완료: %s\n", r.CheckName)
+	}
+	report, err := checklist.Run(ctx, opts)
+	if report == nil {
+		fmt.Println("체크 실행 오류:", err)
+		os.Exit(1)
+	}
+	if err != nil {
+		// 중단된 경우에도 실행한 체크까지의 결과로 TUI 표시
+		printIncomplete(report, err)
+	}
 
-		// Cluster Autoscaler 적용 - Automatic
-		common.PrintResult(reliability.CheckClusterAutoscalerEnabled(k8sClient))
+	var items []tui.Item
+	for _, r := range report.Results {
+		items = append(items, tui.Item{Category: r.Category, Result: r.CheckResult()})
+	}
 
-		// Karpenter 기반 노드 생성 - Automatic
-		common.PrintResult(reliability.CheckKarpenterNode(scalability.GetKarpenter(k8sClient), dynamicClient))
+	err = tui.Run(items, tui.Options{
+		Title:      opts.ClusterName,
+		Categories: checks.Categories,
+		Filter:     strings.ToLower(outputFilter),
+		ExportDir:  layout.Dir,
+	})
+	// TUI에서 내보낸 파일까지 포함해 산출물 목록 작성
+	finishRun(cmd, layout, reportResults(report))
+	if err != nil {
+		fmt.Println("TUI 실행 오류:", err)
+		os.Exit(1)
+	}
+}
 
-		// 다수의 가용 영역에 데이터 플레인 노드 배포 - Automatic
-		common.PrintResult(reliability.CheckNodeMultiAZ(k8sClient))
+// newRunLayout --output-dir, --output-name으로 이번 실행의 출력 디렉터리를 정하고 보고서 저장 위치로 설정
+func newRunLayout(vars artifacts.NameVars) *artifacts.Layout {
+	layout, err := artifacts.New(outputDir, outputName, vars)
+	if err != nil {
+		fmt.Printf("오류: %v\n", err)
+		os.Exit(1)
+	}
+	common.SetReportDir(layout.Dir)
+	return layout
+}
 
-		// PV 사용시 volume affinity 위반 사항 체크 - Manual (PV 어피니티 전부다 출력)
-		common.PrintResult(reliability.CheckVolumeAffinity(k8sClient, cfg, cluster))
+// finishRun 실행 디렉터리에 체크 결과(results.json)와 산출물 목록(index.json)을 저장하고, --bundle이 있으면 증적 번들 생성
+// 결과/목록 저장에 실패해도 점검 결과에는 영향이 없으므로 경고만 출력하며, 번들 생성 실패는 오류로 종료
+func finishRun(cmd *cobra.Command, layout *artifacts.Layout, results []artifacts.Result) {
+	if _, err := layout.WriteResults(results); err != nil {
+		fmt.Printf(common.Yellow+"경고: %v"+common.Reset+"\n", err)
+	}
+	path, err := layout.WriteIndex(results, time.Now())
+	if err != nil {
+		fmt.Printf(common.Yellow+"경고: %v"+common.Reset+"\n", err)
+	} else {
+		fmt.Printf("산출물 목록: %s\n", path)
+	}
 
-		// CoreDNS에 HPA 적용 - Automatic
-		common.PrintResult(reliability.CheckCoreDNSHpa(k8sClient))
+	if bundlePath == "" {
+		return
+	}
+	meta := bundle.NewMetadata(time.Now())
+	meta.Command = cmd.CommandPath()
+	meta.Args = cmd.Flags().Args()
+	meta.Flags = map[string]string{}
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		meta.Flags[f.Name] = f.Value.String()
+	})
+	meta.Cluster, meta.Account, meta.Region = layout.Vars.Cluster, layout.Vars.Account, layout.Vars.Region
+
+	if err := bundle.CreateFile(bundlePath, layout.Dir, meta, bundleKey); err != nil {
+		fmt.Printf("증적 번들 생성 실패: %v\n", err)
+		os.Exit(1)
+	}
+	signed := ""
+	if bundleKey != nil {
+		signed = " (ed25519 서명 포함)"
+	}
+	fmt.Printf("증적 번들: %s%s\n", bundlePath, signed)
+}
 
-		// DNS 캐시 적용 - Automatic
-		common.PrintResult(reliability.CheckCoreDNSCache(k8sClient))
+// reportResults 실행한 체크 결과를 results.json 형식으로 변환
+func reportResults(report *checklist.Report) []artifacts.Result {
+	var results []artifacts.Result
+	for _, r := range report.Results {
+		results = append(results, artifactResult(r.ID, r.Category, r.CheckResult()))
+	}
+	return results
+}
 
-		// // Karpenter 사용시 DaemonSet에 Priority Class 부여 - Automatic
-		common.PrintResult(reliability.CheckDaemonSetPriorityClass(scalability.GetKarpenter(k8sClient), k8sClient))
+// artifactResult 체크 결과를 results.json 형식으로 변환 (id가 비어 있으면 "[ID] 이름" 형식의 체크 이름에서 추출)
+func artifactResult(id, category string, r common.CheckResult) artifacts.Result {
+	if id == "" && strings.HasPrefix(r.CheckName, "[") {
+		if end := strings.Index(r.CheckName, "]"); end > 0 {
+			id = r.CheckName[1:end]
+		}
+	}
+	result := artifacts.Result{
+		ID:        id,
+		Category:  category,
+		CheckName: r.CheckName,
+		Status:    common.ResultStatus(r),
+		Resources: r.Resources,
+		Runbook:   r.Runbook,
+	}
+	if !r.Passed {
+		result.Message = r.FailureMsg
+	}
+	return result
+}
 
-		// Network 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("Network Check")
+// configureOutput --filter, --output, --sort 플래그를 검증하고 출력 설정에 반영
+func configureOutput() {
+	common.SetSortMode(sortMode)
+	common.SetInlineRunbook(inlineRunbook)
 
-		// VPC 서브넷에 충분한 IP 대역대 확보 - Automatic/Manual
-		common.PrintResult(network.CheckVpcSubnetIpCapacity(network.EksCluster(eksCluster), cfg))
+	// 점검을 실행한 뒤 출력 디렉터리 이름 때문에 실패하지 않도록 이름 템플릿을 미리 확인
+	if err := artifacts.ValidateNameTemplate(outputName); err != nil {
+		fmt.Printf("오류: %v\n", err)
+		os.Exit(1)
+	}
 
-		// Pod에 부여할 IP 부족시 알림 설정 - Manual
-		common.PrintResult(network.CheckPodIPAlarm())
+	// 증적 번들 서명 키도 점검 실행 전에 확인
+	if bundleKeyPath != "" {
+		if bundlePath == "" {
+			fmt.Println("오류: --bundle-key는 --bundle과 함께 지정해야 합니다")
+			os.Exit(1)
+		}
+		key, err := bundle.LoadPrivateKey(bundleKeyPath)
+		if err != nil {
+			fmt.Printf("오류: 번들 서명 키를 사용할 수 없습니다: %v\n", err)
+			os.Exit(1)
+		}
+		bundleKey = key
+	}
 
-		// VPC CNI의 Prefix 모드 사용 - Automatic
-		common.PrintResult(network.CheckVpcCniPrefixMode(k8sClient))
+	if outputFilter != "" {
+		// 소문자로 변환하여 비교
+		lowerFilter := strings.ToLower(outputFilter)
+		validFilters := []string{"all", "pass", "fail", "manual"}
+		isValid := false
 
-		// 사용 사례에 맞는 로드밸런서 사용(ALB or NLB) - Manual
-		common.PrintResult(network.CheckLoadBalancerUsage(k8sClient, cfg, cluster))
+		for _, valid := range validFilters {
+			if lowerFilter == valid {
+				isValid = true
+				break
+			}
+		}
 
-		// AWS Load Balancer Controller 사용 - Automatic
-		common.PrintResult(network.CheckAwsLoadBalancerController(k8sClient))
+		if !isValid {
+			fmt.Printf("오류: 유효하지 않은 출력 필터 '%s'\n", outputFilter)
+			fmt.Println("유효한 값: all, pass, fail, manual")
+			os.Exit(1)
+		}
 
-		// ALB/NLB의 대상으로 Pod의 IP 사용 - Automatic
-		common.PrintResult(network.CheckAwsLoadBalancerPodIp(network.CheckAwsLoadBalancerController(k8sClient), k8sClient))
+		fmt.Printf("Output filter: %s\n", lowerFilter)
+		common.SetOutputFilter(lowerFilter)
+	}
 
-		// Pod Readiness Gate 적용 - Automatic
-		common.PrintResult(network.CheckReadinessGateEnabled(network.CheckAwsLoadBalancerController(k8sClient), k8sClient))
+	// 출력 형식 설정
+	if outputFormat != "" {
+		lowerFormat := strings.ToLower(outputFormat)
+		validFormats := []string{"text", "html", "pdf"}
+		isValid := false
 
-		// kube-proxy에 IPVS 모드 적용 - Automatic
-		common.PrintResult(network.CheckKubeProxyIPVSMode(k8sClient))
+		for _, valid := range validFormats {
+			if lowerFormat == valid {
+				isValid = true
+				break
+			}
+		}
 
-		// Endpoint 대신 EndpointSlices 사용 - Automatic
-		common.PrintResult(network.EndpointSlicesCheck(k8sClient))
+		if !isValid {
+			fmt.Printf("오류: 유효하지 않은 출력 형식 '%s'\n", outputFormat)
+			fmt.Println("유효한 값: text, html, pdf")
+			os.Exit(1)
+		}
 
-		// 비용최적화 항목 체크 기능은 하단 항목에 추가
-		common.PrintCategoryHeader("Cost-Optimized Check")
+		common.SetOutputFormat(lowerFormat)
+	}
 
-		// EKS용 Kubecost 설치 - Automatic
-		common.PrintResult(cost.GetKubecost(k8sClient))
+	// PDF 생성 방식 설정
+	if outputFormat == "pdf" {
+		if !slices.Contains(common.PDFEngines, strings.ToLower(pdfEngine)) {
+			fmt.Printf("오류: 유효하지 않은 PDF 생성 방식 '%s'\n", pdfEngine)
+			fmt.Printf("유효한 값: %s\n", strings.Join(common.PDFEngines, ", "))
+			os.Exit(1)
+		}
+		common.SetPDFEngine(pdfEngine)
+	}
 
-		// 요약본
-		common.PrintSummary()
-	},
+	// HTML 출력 초기화
+	if outputFormat == "html" || outputFormat == "pdf" {
+		// 점검을 모두 실행한 뒤 보고서 생성 단계에서 실패하지 않도록 템플릿을 미리 확인 (HTML을 거치는 경우만)
+		common.SetTemplatePath(templatePath)
+		if outputFormat == "html" || common.PDFEngine == common.PDFEngineWkhtmltopdf {
+			if err := common.ValidateTemplate(); err != nil {
+				fmt.Printf("오류: 보고서 템플릿을 사용할 수 없습니다: %v\n", err)
+				os.Exit(1)
+			}
+		}
+		common.InitHTMLOutput()
+	}
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
+	// Ctrl-C(SIGINT) 또는 SIGTERM을 받으면 진행 중인 API 호출을 취소하고 부분 결과를 출력
+	// 취소 이후 한 번 더 누르면 기본 동작대로 즉시 종료
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
 		fmt.Println(err)
 		os.Exit(-1)
 	}
@@ -288,6 +409,25 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&kubeconfigPath, "kubeconfig", filepath.Join(homedir.HomeDir(), ".kube", "config"), "Path to the kubeconfig file to use for CLI requests")
 	rootCmd.PersistentFlags().StringVar(&kubeconfigContext, "context", "", "The name of the kubeconfig context to use")
 	rootCmd.PersistentFlags().StringVar(&outputFilter, "filter", "", "출력 결과 필터링 (all, pass, fail, manual)")
-	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "text", "출력 형식 (text, html)")
+	rootCmd.PersistentFlags().StringVar(&outputFormat, "output", "text", "출력 형식 (text, html, pdf)")
 	rootCmd.PersistentFlags().BoolVar(&sortMode, "sort", false, "결과를 상태별(PASS/FAIL/MANUAL)로 정렬하여 출력")
+	rootCmd.PersistentFlags().StringVar(&pdfEngine, "pdf-engine", common.PDFEngineNative, "PDF 생성 방식 (native: 외부 프로그램 없이 생성, wkhtmltopdf: HTML 보고서를 wkhtmltopdf로 변환)")
+	rootCmd.PersistentFlags().StringVar(&templatePath, "template", "", "HTML/PDF 보고서 템플릿 파일 (비어 있으면 바이너리에 포함된 기본 템플릿)")
+	rootCmd.PersistentFlags().StringVar(&outputDir, "output-dir", artifacts.DefaultOutputDir, "보고서와 증적 파일을 저장할 디렉터리 (실행마다 하위 디렉터리 생성)")
+	rootCmd.PersistentFlags().StringVar(&outputName, "output-name", artifacts.DefaultNameTemplate, "실행별 하위 디렉터리 이름 템플릿 ({cluster}, {account}, {region}, {timestamp}, '/'로 하위 디렉터리 구분)")
+	rootCmd.PersistentFlags().StringVar(&bundlePath, "bundle", "", "보고서, 체크 결과, 증적 파일을 SHA-256 목록과 함께 묶은 증적 번들(tar.gz) 저장 경로")
+	rootCmd.PersistentFlags().StringVar(&bundleKeyPath, "bundle-key", "", "증적 번들의 SHA-256 목록에 서명할 ed25519 개인 키 (PEM, PKCS#8)")
+	rootCmd.PersistentFlags().StringSliceVar(&clusterAdminAllowlist, "cluster-admin-allowlist", nil, "AmazonEKSClusterAdminPolicy(cluster 범위) 연결을 허용할 principal ARN 패턴 (쉼표 구분, *는 임의의 문자열, SEC-002)")
+	rootCmd.PersistentFlags().BoolVar(&restrictSGEgress, "restrict-sg-egress", false, "인터넷(0.0.0.0/0, ::/0)으로 열린 보안 그룹 아웃바운드 규칙을 실패로 판정 (아웃바운드 제한 정책이 있는 경우, SEC-019)")
+	rootCmd.PersistentFlags().BoolVar(&inlineRunbook, "inline-runbook", false, "HTML/PDF 보고서에 통과하지 못한 체크의 런북 내용 포함 (인터넷 접근이 불가한 환경용)")
+	rootCmd.Flags().BoolVar(&tuiMode, "tui", false, "체크 결과를 대화형 터미널 UI로 탐색 (카테고리, 상태 필터, 검색, 런북, 내보내기)")
+	rootCmd.PersistentFlags().DurationVar(&runTimeout, "timeout", 0, "전체 점검 최대 실행 시간 (예: 10m, 0이면 제한 없음). 초과 시 실행한 체크까지의 부분 보고서 출력 (--watch와 함께 사용 불가)")
+	rootCmd.PersistentFlags().Float32Var(&kubeQPS, "kube-qps", kube.DefaultQPS, "Kubernetes API 클라이언트 초당 요청 수 제한 (QPS)")
+	rootCmd.PersistentFlags().IntVar(&kubeBurst, "kube-burst", kube.DefaultBurst, "Kubernetes API 클라이언트 순간 최대 요청 수 (Burst)")
+	rootCmd.PersistentFlags().IntVar(&awsMaxAttempts, "aws-max-attempts", awsMaxAttempts, "AWS API 스로틀링 등 재시도 가능한 오류 발생 시 최대 시도 횟수")
+	rootCmd.PersistentFlags().DurationVar(&checkTimeout, "check-timeout", 2*time.Minute, "체크별 최대 실행 시간 (0이면 제한 없음). 초과한 체크는 TIMEOUT으로 표시")
+	rootCmd.Flags().BoolVar(&watchMode, "watch", false, "리소스 변경을 감시하며 영향받는 체크만 다시 실행하고 상태 변경 내역을 출력")
+	rootCmd.Flags().StringVar(&watchFormat, "watch-format", "text", "감시 모드 출력 형식 (text, json)")
+	rootCmd.Flags().DurationVar(&watchDebounce, "watch-debounce", 5*time.Second, "리소스 변경 후 체크를 다시 실행하기까지 대기 시간")
+	rootCmd.Flags().DurationVar(&watchAWSInterval, "watch-aws-interval", 5*time.Minute, "AWS API가 필요한 체크의 갱신 주기 (0이면 최초 1회만 실행)")
 }
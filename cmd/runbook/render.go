@@ -0,0 +1,214 @@
+package runbook
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+// 터미널 출력용 ANSI 색상 (common 패키지의 출력 색상과 같은 방식)
+const (
+	ansiBold   = "\033[1m"
+	ansiYellow = "\033[33m"
+	ansiCyan   = "\033[36m"
+	ansiGray   = "\033[90m"
+	ansiReset  = "\033[0m"
+)
+
+var (
+	orderedItemPattern = regexp.MustCompile(`^(\d+)\.\s+(.*)$`)
+	linkPattern        = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	codePattern        = regexp.MustCompile("`([^`]+)`")
+	boldPattern        = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+	tableRulePattern   = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+	blankLinesPattern  = regexp.MustCompile(`\n{3,}`)
+)
+
+// RenderText 런북 마크다운을 터미널 출력용 텍스트로 변환
+// 제목, 코드 블록, 목록, 굵은 글씨, 링크 정도만 구분하며 color가 false이면 색상 코드 없이 출력
+func RenderText(markdown string, color bool) string {
+	style := func(codes, text string) string {
+		if !color {
+			return text
+		}
+		return codes + text + ansiReset
+	}
+
+	var b strings.Builder
+	inCode := false
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			b.WriteString(style(ansiGray, "    "+line) + "\n")
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "# "):
+			b.WriteString(style(ansiBold+ansiYellow, strings.TrimPrefix(trimmed, "# ")) + "\n")
+		case strings.HasPrefix(trimmed, "#"):
+			b.WriteString("\n" + style(ansiBold+ansiCyan, strings.TrimSpace(strings.TrimLeft(trimmed, "#"))) + "\n")
+		case tableRulePattern.MatchString(trimmed) && strings.Contains(trimmed, "|"):
+			// 표 구분선은 생략
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+			b.WriteString("  " + indent + "• " + renderTextInline(trimmed[2:], style) + "\n")
+		default:
+			b.WriteString(renderTextInline(line, style) + "\n")
+		}
+	}
+	// 연속된 빈 줄은 하나로 줄임
+	return blankLinesPattern.ReplaceAllString(strings.TrimRight(b.String(), "\n"), "\n\n") + "\n"
+}
+
+// renderTextInline **굵게**, `코드`, [링크](URL) 표시를 터미널용으로 변환
+func renderTextInline(text string, style func(codes, text string) string) string {
+	text = linkPattern.ReplaceAllString(text, "$1 ($2)")
+	text = boldPattern.ReplaceAllStringFunc(text, func(m string) string {
+		return style(ansiBold, boldPattern.FindStringSubmatch(m)[1])
+	})
+	return codePattern.ReplaceAllStringFunc(text, func(m string) string {
+		return style(ansiCyan, codePattern.FindStringSubmatch(m)[1])
+	})
+}
+
+// RenderHTML 런북 마크다운을 보고서에 넣을 HTML 조각으로 변환
+// 모든 텍스트는 이스케이프하며, 외부(http/https) 링크만 링크로 남기고 상대 경로 링크는 텍스트로 표시
+// 제목은 보고서 구조를 해치지 않도록 h5/h6으로 낮춤
+func RenderHTML(markdown string) string {
+	var b strings.Builder
+	var paragraph []string
+	var list string // 열려 있는 목록 태그 ("ul", "ol")
+	var table [][]string
+	var code []string
+	inCode := false
+
+	closeParagraph := func() {
+		if len(paragraph) > 0 {
+			b.WriteString("<p>" + strings.Join(paragraph, "<br>") + "</p>\n")
+			paragraph = nil
+		}
+	}
+	closeList := func() {
+		if list != "" {
+			b.WriteString("</" + list + ">\n")
+			list = ""
+		}
+	}
+	closeTable := func() {
+		if len(table) == 0 {
+			return
+		}
+		b.WriteString("<table class=\"table table-sm table-bordered\">\n")
+		for i, row := range table {
+			cell := "td"
+			if i == 0 {
+				cell = "th"
+			}
+			b.WriteString("<tr>")
+			for _, c := range row {
+				b.WriteString("<" + cell + ">" + renderHTMLInline(c) + "</" + cell + ">")
+			}
+			b.WriteString("</tr>\n")
+		}
+		b.WriteString("</table>\n")
+		table = nil
+	}
+	closeBlocks := func() {
+		closeParagraph()
+		closeList()
+		closeTable()
+	}
+	openList := func(tag string) {
+		closeParagraph()
+		closeTable()
+		if list != tag {
+			closeList()
+			b.WriteString("<" + tag + ">\n")
+			list = tag
+		}
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			if inCode {
+				b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+				code = nil
+			} else {
+				closeBlocks()
+			}
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			code = append(code, line)
+			continue
+		}
+
+		switch {
+		case trimmed == "":
+			closeBlocks()
+		case strings.HasPrefix(trimmed, "#"):
+			closeBlocks()
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			tag := "h6"
+			if level <= 2 {
+				tag = "h5"
+			}
+			b.WriteString("<" + tag + ">" + renderHTMLInline(strings.TrimSpace(strings.TrimLeft(trimmed, "#"))) + "</" + tag + ">\n")
+		case strings.HasPrefix(trimmed, "|"):
+			closeParagraph()
+			closeList()
+			if !tableRulePattern.MatchString(trimmed) {
+				table = append(table, splitTableRow(trimmed))
+			}
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			openList("ul")
+			b.WriteString("<li>" + renderHTMLInline(trimmed[2:]) + "</li>\n")
+		case orderedItemPattern.MatchString(trimmed):
+			openList("ol")
+			b.WriteString("<li>" + renderHTMLInline(orderedItemPattern.FindStringSubmatch(trimmed)[2]) + "</li>\n")
+		default:
+			closeList()
+			closeTable()
+			paragraph = append(paragraph, renderHTMLInline(trimmed))
+		}
+	}
+	if inCode {
+		b.WriteString("<pre><code>" + html.EscapeString(strings.Join(code, "\n")) + "</code></pre>\n")
+	}
+	closeBlocks()
+
+	return b.String()
+}
+
+// splitTableRow | a | b | 형식의 표 행을 셀로 분리
+func splitTableRow(row string) []string {
+	cells := strings.Split(strings.Trim(row, "|"), "|")
+	for i := range cells {
+		cells[i] = strings.TrimSpace(cells[i])
+	}
+	return cells
+}
+
+// renderHTMLInline 이스케이프 후 **굵게**, `코드`, 외부 링크를 HTML 태그로 변환
+func renderHTMLInline(text string) string {
+	text = html.EscapeString(text)
+	text = codePattern.ReplaceAllString(text, "<code>$1</code>")
+	text = boldPattern.ReplaceAllString(text, "<strong>$1</strong>")
+	return linkPattern.ReplaceAllStringFunc(text, func(m string) string {
+		parts := linkPattern.FindStringSubmatch(m)
+		if strings.HasPrefix(parts[2], "http://") || strings.HasPrefix(parts[2], "https://") {
+			return `<a href="` + parts[2] + `" target="_blank">` + parts[1] + `</a>`
+		}
+		return parts[1]
+	})
+}
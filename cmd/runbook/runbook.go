@@ -0,0 +1,124 @@
+package runbook
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"strings"
+
+	"eks-checklist/docs"
+)
+
+// ReportSections 보고서에 포함하는 런북 섹션 (모든 런북에 공통으로 있는 섹션)
+var ReportSections = []string{"Meaning", "Impact", "Diagnosis", "Mitigation"}
+
+// Section 런북의 ## 제목 단위 구획
+type Section struct {
+	Title string
+	Body  string
+}
+
+var urlPattern = regexp.MustCompile(`/runbook/([a-z]+)/([A-Z]+-\d+)/?$`)
+
+// Ref 런북 URL에서 카테고리(docs/runbook 하위 디렉터리)와 체크 ID 추출
+func Ref(url string) (string, string, bool) {
+	m := urlPattern.FindStringSubmatch(url)
+	if m == nil {
+		return "", "", false
+	}
+	return m[1], m[2], true
+}
+
+// Load 바이너리에 포함된 runbook/<category>/<ID>.md 런북을 읽음
+func Load(category, id string) (string, error) {
+	data, err := fs.ReadFile(docs.Runbooks, path.Join("runbook", category, id+".md"))
+	if err != nil {
+		return "", fmt.Errorf("런북을 찾을 수 없습니다: %s/%s", category, id)
+	}
+	return string(data), nil
+}
+
+// LoadURL 체크 결과의 Runbook URL에 해당하는 런북을 읽음
+func LoadURL(url string) (string, error) {
+	category, id, ok := Ref(url)
+	if !ok {
+		return "", fmt.Errorf("런북 URL 형식이 아닙니다: %s", url)
+	}
+	return Load(category, id)
+}
+
+// Find 체크 ID로 런북을 찾아 카테고리와 내용을 반환 (대소문자 구분 없음)
+func Find(id string) (string, string, error) {
+	id = strings.ToUpper(strings.TrimSpace(id))
+	categories, err := fs.ReadDir(docs.Runbooks, "runbook")
+	if err != nil {
+		return "", "", err
+	}
+	for _, category := range categories {
+		if !category.IsDir() {
+			continue
+		}
+		if markdown, err := Load(category.Name(), id); err == nil {
+			return category.Name(), markdown, nil
+		}
+	}
+	return "", "", fmt.Errorf("체크 ID '%s'에 해당하는 런북이 없습니다", id)
+}
+
+// Parse 런북을 제목(# )과 ## 섹션으로 분리
+// 첫 ## 이전의 본문은 제목 없는 섹션으로 반환하며, 코드 블록 안의 # 줄은 제목으로 보지 않음
+func Parse(markdown string) (string, []Section) {
+	var title string
+	var sections []Section
+	var current *Section
+	var body []string
+	inCode := false
+
+	flush := func() {
+		text := strings.Trim(strings.Join(body, "\n"), "\n")
+		if current != nil {
+			current.Body = text
+			sections = append(sections, *current)
+		} else if strings.TrimSpace(text) != "" {
+			sections = append(sections, Section{Body: text})
+		}
+		body = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+		}
+		switch {
+		case !inCode && title == "" && current == nil && strings.HasPrefix(trimmed, "# "):
+			title = strings.TrimSpace(strings.TrimPrefix(trimmed, "# "))
+		case !inCode && strings.HasPrefix(trimmed, "## "):
+			flush()
+			current = &Section{Title: strings.TrimSpace(strings.TrimPrefix(trimmed, "## "))}
+		default:
+			body = append(body, line)
+		}
+	}
+	flush()
+
+	return title, sections
+}
+
+// Excerpt 지정한 섹션만 원래 순서대로 마크다운으로 다시 조합 (섹션 이름은 대소문자 구분 없음)
+func Excerpt(markdown string, titles []string) string {
+	wanted := make(map[string]bool, len(titles))
+	for _, t := range titles {
+		wanted[strings.ToLower(t)] = true
+	}
+
+	_, sections := Parse(markdown)
+	var parts []string
+	for _, s := range sections {
+		if s.Title != "" && wanted[strings.ToLower(s.Title)] {
+			parts = append(parts, "## "+s.Title+"\n"+s.Body)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
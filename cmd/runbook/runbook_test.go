@@ -0,0 +1,90 @@
+package runbook_test
+
+import (
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/runbook"
+	"eks-checklist/cmd/testutils"
+)
+
+// TestEmbeddedRunbooks 등록된 모든 체크의 런북이 바이너리에 포함되어 있고 보고서용 섹션이 있는지 확인
+func TestEmbeddedRunbooks(t *testing.T) {
+	for _, c := range checks.All {
+		markdown, err := runbook.LoadURL(c.Runbook())
+		if err != nil {
+			t.Errorf("%s: %v", c.ID, err)
+			continue
+		}
+		title, _ := runbook.Parse(markdown)
+		if !strings.HasPrefix(title, c.ID) {
+			t.Errorf("%s: unexpected runbook title %q", c.ID, title)
+		}
+		for _, section := range runbook.ReportSections {
+			if runbook.Excerpt(markdown, []string{section}) == "" {
+				t.Errorf("%s: missing section %s", c.ID, section)
+			}
+		}
+	}
+}
+
+func TestFind(t *testing.T) {
+	category, markdown, err := runbook.Find(" sec-005 ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if category != "security" || !strings.HasPrefix(markdown, "# SEC-005") {
+		t.Errorf("unexpected runbook: %s %q", category, markdown[:20])
+	}
+
+	if _, _, err := runbook.Find("SEC-999"); err == nil {
+		t.Error("expected error for unknown check ID")
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	markdown := "# ID 제목\n\n## Meaning\n의미\n\n## Impact\n영향\n```bash\n## 코드 안의 제목\n```\n## Mitigation\n조치\n"
+
+	excerpt := runbook.Excerpt(markdown, []string{"mitigation", "meaning"})
+	if excerpt != "## Meaning\n의미\n\n## Mitigation\n조치" {
+		t.Errorf("unexpected excerpt: %q", excerpt)
+	}
+	if impact := runbook.Excerpt(markdown, []string{"Impact"}); !strings.Contains(impact, "## 코드 안의 제목") {
+		t.Errorf("heading inside code block should stay in section body: %q", impact)
+	}
+}
+
+func TestRender(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "runbook_render.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			markdown := tc["markdown"].(string)
+
+			html := runbook.RenderHTML(markdown)
+			for _, expect := range testutils.ToStrings(tc["expect_html_contains"]) {
+				if !strings.Contains(html, expect) {
+					t.Errorf("Test '%s' failed: expected HTML to contain %q, got:\n%s", testName, expect, html)
+				}
+			}
+			for _, missing := range testutils.ToStrings(tc["expect_html_missing"]) {
+				if strings.Contains(html, missing) {
+					t.Errorf("Test '%s' failed: expected HTML not to contain %q", testName, missing)
+				}
+			}
+
+			text := runbook.RenderText(markdown, false)
+			if strings.Contains(text, "\033[") {
+				t.Errorf("Test '%s' failed: color codes in plain text output", testName)
+			}
+			for _, expect := range testutils.ToStrings(tc["expect_text_contains"]) {
+				if !strings.Contains(text, expect) {
+					t.Errorf("Test '%s' failed: expected text to contain %q, got:\n%s", testName, expect, text)
+				}
+			}
+		})
+	}
+}
@@ -5,13 +5,14 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckSpotNodeTerminationHandler checks whether the Spot Termination Handler is deployed.
-func CheckSpotNodeTerminationHandler(client kubernetes.Interface) common.CheckResult {
+func CheckSpotNodeTerminationHandler(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-003] Spot 노드 사용시 Spot 중지 핸들러 적용",
 		Manual:     false,
@@ -20,7 +21,7 @@ func CheckSpotNodeTerminationHandler(client kubernetes.Interface) common.CheckRe
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-003",
 	}
 
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -44,7 +44,7 @@ func TestCheckSpotNodeTerminationHandler(t *testing.T) {
 			}
 
 			// 함수 실행
-			result := scalability.CheckSpotNodeTerminationHandler(client)
+			result := scalability.CheckSpotNodeTerminationHandler(context.Background(), client)
 
 			if result.Passed != !expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, !expectPass, result.Passed)
@@ -5,13 +5,14 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // GetKarpenter checks if the Karpenter deployment is installed in the cluster.
-func GetKarpenter(client kubernetes.Interface) common.CheckResult {
+func GetKarpenter(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-001] Karpenter 사용",
 		Manual:     false,
@@ -20,7 +21,7 @@ func GetKarpenter(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-001",
 	}
 
-	deploys, err := client.AppsV1().Deployments("").List(context.TODO(), v1.ListOptions{})
+	deploys, err := kube.ListAll(ctx, client.AppsV1().Deployments("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -49,7 +49,7 @@ func TestGetKarpenter(t *testing.T) {
 				}
 			}
 
-			result := scalability.GetKarpenter(client)
+			result := scalability.GetKarpenter(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, !expectPass, result.Passed)
@@ -9,11 +9,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckGracefulShutdown(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckGracefulShutdown(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-005] Application에 Graceful shutdown 적용",
 		Manual:     true,
@@ -22,14 +23,12 @@ func CheckGracefulShutdown(client kubernetes.Interface, cfg aws.Config, eksClust
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-005",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-graceful-shutdown")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
-	ctx := context.TODO()
-	podList, err := client.CoreV1().Pods("").List(ctx, v1.ListOptions{})
+	podList, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "Pod 목록 조회 실패: " + err.Error()
 		return result
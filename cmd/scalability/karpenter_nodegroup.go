@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckNodeGroupUsage는 Karpenter 전용 노드 그룹 또는 Fargate 사용 여부를 검사합니다.
-func CheckNodeGroupUsage(client kubernetes.Interface) common.CheckResult {
+func CheckNodeGroupUsage(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-002] Karpenter 전용 노드 그룹 혹은 Fargate 사용",
 		Manual:     false,
@@ -20,7 +21,7 @@ func CheckNodeGroupUsage(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-002",
 	}
 
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metaV1.ListOptions{})
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, metaV1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
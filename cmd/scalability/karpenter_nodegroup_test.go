@@ -54,7 +54,7 @@ func TestCheckNodeGroupUsage(t *testing.T) {
 			}
 
 			// 실제 검사 함수 호출 (CheckNodeGroupUsage는 common.CheckResult를 반환)
-			result := CheckNodeGroupUsage(client)
+			result := CheckNodeGroupUsage(context.Background(), client)
 
 			// 최종 결과 비교: result.Passed와 expectPass가 같아야 함.
 			if result.Passed != expectPass {
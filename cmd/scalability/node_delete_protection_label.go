@@ -9,11 +9,12 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 )
 
-func CheckImportantPodProtection(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckImportantPodProtection(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-004] 중요 Pod에 노드 삭제 방지용 Label 부여 - Manual",
 		Manual:     true,
@@ -22,16 +23,13 @@ func CheckImportantPodProtection(client kubernetes.Interface, cfg aws.Config, ek
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-004",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-important-pod-protection")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
-	ctx := context.TODO()
-
 	// Pod 목록 조회
-	podList, err := client.CoreV1().Pods("").List(ctx, v1.ListOptions{})
+	podList, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.FailureMsg = "Pod 목록 조회 실패: " + err.Error()
 		return result
@@ -39,7 +37,7 @@ func CheckImportantPodProtection(client kubernetes.Interface, cfg aws.Config, ek
 
 	// Node 정보 조회
 	nodeMap := map[string]map[string]string{}
-	nodeList, err := client.CoreV1().Nodes().List(ctx, v1.ListOptions{})
+	nodeList, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
 	if err == nil {
 		for _, node := range nodeList.Items {
 			nodeMap[node.Name] = node.Labels
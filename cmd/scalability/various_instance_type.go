@@ -6,13 +6,15 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckInstanceTypes checks if the cluster uses multiple instance types (e.g., for cost optimization, flexibility).
-func CheckInstanceTypes(client kubernetes.Interface) common.CheckResult {
+func CheckInstanceTypes(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SCL-007] 다양한 인스턴스 타입 사용",
 		Manual:     false,
@@ -21,7 +23,7 @@ func CheckInstanceTypes(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-007",
 	}
 
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -52,3 +54,32 @@ func CheckInstanceTypes(client kubernetes.Interface) common.CheckResult {
 
 	return result
 }
+
+// CheckNodegroupInstanceTypes 노드 그룹 구성(IaC 등)에 지정된 인스턴스 타입이 다양한지 확인
+func CheckNodegroupInstanceTypes(nodegroups []ekstypes.Nodegroup) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SCL-007] 다양한 인스턴스 타입 사용",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "클러스터에서 단일 인스턴스 타입만 사용 중입니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/scalability/SCL-007",
+	}
+
+	instanceTypes := make(map[string]bool)
+	for _, ng := range nodegroups {
+		name := ""
+		if ng.NodegroupName != nil {
+			name = *ng.NodegroupName
+		}
+		for _, instanceType := range ng.InstanceTypes {
+			instanceTypes[instanceType] = true
+		}
+		result.Resources = append(result.Resources, fmt.Sprintf("Nodegroup: %s | InstanceTypes: %s", name, strings.Join(ng.InstanceTypes, ", ")))
+	}
+
+	if len(instanceTypes) <= 1 {
+		result.Passed = false
+	}
+
+	return result
+}
@@ -42,7 +42,7 @@ func TestCheckInstanceTypes(t *testing.T) {
 				client.CoreV1().Nodes().Create(context.TODO(), node, v1.CreateOptions{})
 			}
 
-			result := scalability.CheckInstanceTypes(client)
+			result := scalability.CheckInstanceTypes(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", name, !expectPass, result.Passed)
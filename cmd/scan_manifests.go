@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"eks-checklist/cmd/artifacts"
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/manifests"
+
+	"github.com/spf13/cobra"
+)
+
+var scanNamespace string
+
+var scanManifestsCmd = &cobra.Command{
+	Use:   "scan-manifests PATH...",
+	Short: "배포 전 매니페스트(YAML)를 대상으로 Kubernetes 체크 실행",
+	Long: `YAML 파일 또는 디렉토리의 매니페스트를 읽어 fake 클라이언트에 적재한 뒤
+Kubernetes 리소스만으로 평가 가능한 체크를 실행합니다.
+AWS API 또는 노드 등 실행 중인 클러스터 정보가 필요한 체크는 N/A로 표시됩니다.
+
+PATH에 '-'를 지정하면 표준 입력에서 매니페스트를 읽습니다.
+  helm template my-release ./chart | eks-checklist scan-manifests -
+  kustomize build overlays/prod | eks-checklist scan-manifests -`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		configureOutput()
+
+		set, err := manifests.Load(args, os.Stdin, scanNamespace)
+		if err != nil {
+			return err
+		}
+		for _, skipped := range set.Skipped {
+			fmt.Printf("건너뜀: %s\n", skipped)
+		}
+		if len(set.Objects) == 0 && len(set.Unstructured) == 0 {
+			return fmt.Errorf("평가할 Kubernetes 객체가 없습니다")
+		}
+
+		fmt.Printf("Running checks on %d manifest objects\n", len(set.Objects)+len(set.Unstructured))
+
+		layout := newRunLayout(artifacts.ClusterVars("manifests", "", "", time.Now()))
+		env := &checks.Env{
+			Client:         set.Clientset(),
+			DynamicClient:  set.DynamicClient(),
+			MetadataClient: set.MetadataClient(),
+			ClusterName:    "manifests",
+			EvidenceDir:    layout.EvidenceDir(),
+		}
+
+		var results []artifacts.Result
+		for _, category := range checks.Categories {
+			common.PrintCategoryHeader(category)
+			for _, check := range checks.ByCategory(category) {
+				var r common.CheckResult
+				if reason := checks.StaticSkipReason(check); reason != "" {
+					r = check.NotApplicableResult(reason)
+				} else {
+					r = check.Execute(cmd.Context(), env)
+				}
+				common.PrintResult(r)
+				results = append(results, artifactResult(check.ID, check.Category, r))
+			}
+		}
+
+		common.PrintSummary()
+		finishRun(cmd, layout, results)
+		return nil
+	},
+}
+
+func init() {
+	scanManifestsCmd.Flags().StringVarP(&scanNamespace, "namespace", "n", "default", "네임스페이스가 지정되지 않은 리소스에 적용할 네임스페이스")
+
+	rootCmd.AddCommand(scanManifestsCmd)
+}
@@ -1,6 +1,8 @@
 package security
 
 import (
+	"errors"
+
 	"eks-checklist/cmd/common"
 
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
@@ -11,6 +13,9 @@ type EksCluster struct {
 	Cluster *types.Cluster
 }
 
+// errNoCluster 클러스터 구성(DescribeCluster 결과)이 필요한 체크에 클러스터 정보가 없는 경우
+var errNoCluster = errors.New("클러스터 정보(DescribeCluster 결과)가 없습니다")
+
 // Audit 로그 활성화 여부를 체크하는 함수
 func CheckAuditLoggingEnabled(eksCluster *EksCluster) common.CheckResult {
 	result := common.CheckResult{
@@ -0,0 +1,280 @@
+package security
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"gopkg.in/yaml.v3"
+)
+
+// AwsAuthMapping aws-auth ConfigMap mapRoles/mapUsers 항목
+type AwsAuthMapping struct {
+	RoleARN  string   `yaml:"rolearn,omitempty" json:"rolearn,omitempty"`
+	UserARN  string   `yaml:"userarn,omitempty" json:"userarn,omitempty"`
+	Username string   `yaml:"username,omitempty" json:"username,omitempty"`
+	Groups   []string `yaml:"groups,omitempty" json:"groups,omitempty"`
+}
+
+// ARN 매핑 대상 IAM 역할 또는 사용자 ARN
+func (m AwsAuthMapping) ARN() string {
+	if m.RoleARN != "" {
+		return m.RoleARN
+	}
+	return m.UserARN
+}
+
+// AwsAuth aws-auth ConfigMap 파싱 결과
+type AwsAuth struct {
+	Roles    []AwsAuthMapping `json:"mapRoles,omitempty"`
+	Users    []AwsAuthMapping `json:"mapUsers,omitempty"`
+	Accounts []string         `json:"mapAccounts,omitempty"`
+}
+
+// AwsAuthIssue aws-auth ConfigMap 점검 결과 항목
+type AwsAuthIssue struct {
+	// Section mapRoles, mapUsers, mapAccounts
+	Section string
+	// Principal 문제가 있는 ARN 또는 계정 ID (파싱 실패 시 비어 있음)
+	Principal string
+	Problem   string
+}
+
+func (i AwsAuthIssue) String() string {
+	if i.Principal == "" {
+		return "aws-auth " + i.Section + ": " + i.Problem
+	}
+	return "aws-auth " + i.Section + " " + i.Principal + ": " + i.Problem
+}
+
+// nodeGroups 노드/Fargate 역할 매핑에 사용하는 그룹 (username에 {{SessionName}}이 필요 없음)
+var nodeGroups = []string{"system:nodes", "system:bootstrappers", "system:node-proxier"}
+
+// ParseAwsAuth aws-auth ConfigMap data의 mapRoles, mapUsers, mapAccounts 파싱
+// 키별로 따로 파싱하므로 한 키가 깨져도 나머지 키는 결과에 포함되며, 파싱 실패는 점검 결과 항목으로 반환
+func ParseAwsAuth(data map[string]string) (AwsAuth, []AwsAuthIssue) {
+	var auth AwsAuth
+	var issues []AwsAuthIssue
+	parse := func(section string, out interface{}) {
+		value, ok := data[section]
+		if !ok {
+			return
+		}
+		if err := yaml.Unmarshal([]byte(value), out); err != nil {
+			issues = append(issues, AwsAuthIssue{
+				Section: section,
+				Problem: "YAML 파싱 실패, EKS가 ConfigMap을 읽지 못하면 모든 IAM principal의 클러스터 접근이 차단될 수 있음 (" + err.Error() + ")",
+			})
+		}
+	}
+	parse("mapRoles", &auth.Roles)
+	parse("mapUsers", &auth.Users)
+	parse("mapAccounts", &auth.Accounts)
+	return auth, issues
+}
+
+// LintAwsAuth aws-auth 매핑 점검
+// - system:masters 그룹 매핑
+// - 와일드카드 ARN, 계정 전체 매핑(mapAccounts, 계정 root ARN)
+// - 중복되거나 서로 다른 username/groups로 충돌하는 ARN
+// - 경로가 포함된 역할 ARN (EKS는 경로를 제외한 ARN으로만 일치)
+// - 역할 매핑 username에 {{SessionName}}이 없어 역할을 수임한 사용자를 구분할 수 없는 경우
+func LintAwsAuth(auth AwsAuth) []AwsAuthIssue {
+	var issues []AwsAuthIssue
+	add := func(section, principal, problem string) {
+		issues = append(issues, AwsAuthIssue{Section: section, Principal: principal, Problem: problem})
+	}
+
+	lint := func(section string, mappings []AwsAuthMapping, role bool) {
+		seen := map[string]AwsAuthMapping{}
+		for _, m := range mappings {
+			principal := m.ARN()
+			if principal == "" {
+				add(section, "", "rolearn/userarn이 없는 항목")
+				continue
+			}
+			if slices.Contains(m.Groups, "system:masters") {
+				add(section, principal, "system:masters 그룹에 매핑 (클러스터 전체 관리자 권한)")
+			}
+			if strings.Contains(principal, "*") {
+				add(section, principal, "와일드카드 ARN 매핑 (EKS는 와일드카드를 지원하지 않으며 의도와 다르게 동작)")
+			}
+			if isAccountRoot(principal) {
+				add(section, principal, "계정 root ARN 매핑 (계정 전체 매핑)")
+			}
+			if role {
+				if base, ok := stripRolePath(principal); ok {
+					add(section, principal, "경로가 포함된 역할 ARN은 EKS에서 일치하지 않음 ("+base+"로 매핑 필요)")
+				}
+				if !isNodeMapping(m) && !strings.Contains(m.Username, "{{SessionName}}") && !strings.Contains(m.Username, "{{SessionNameRaw}}") {
+					add(section, principal, fmt.Sprintf("username '%s'에 {{SessionName}}이 없어 역할을 수임한 사용자를 감사 로그에서 구분할 수 없음", m.Username))
+				}
+			}
+
+			key := principal
+			if role {
+				if base, ok := stripRolePath(principal); ok {
+					key = base
+				}
+			}
+			if prev, ok := seen[key]; ok {
+				if prev.Username == m.Username && sameGroups(prev.Groups, m.Groups) {
+					add(section, principal, "중복 매핑")
+				} else {
+					add(section, principal, "서로 다른 username/groups로 중복 매핑되어 충돌 (EKS는 하나만 적용)")
+				}
+				continue
+			}
+			seen[key] = m
+		}
+	}
+	lint("mapRoles", auth.Roles, true)
+	lint("mapUsers", auth.Users, false)
+
+	for _, account := range auth.Accounts {
+		add("mapAccounts", account, "계정 전체 매핑 (계정의 모든 IAM 사용자가 클러스터에 인증 가능)")
+	}
+	return issues
+}
+
+// AccessEntryMigration aws-auth 매핑과 같은 권한을 주는 Access entry
+type AccessEntryMigration struct {
+	// Source 원본 aws-auth 항목 (mapRoles, mapUsers)
+	Source           string   `json:"source"`
+	PrincipalArn     string   `json:"principalArn"`
+	Type             string   `json:"type"`
+	Username         string   `json:"username,omitempty"`
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+	// AccessPolicies 연결할 Access policy (system:masters 그룹 대체)
+	AccessPolicies []string `json:"accessPolicies,omitempty"`
+	Notes          []string `json:"notes,omitempty"`
+	// Commands Access entry 생성 AWS CLI 명령
+	Commands []string `json:"commands"`
+}
+
+// AwsAuthMigration aws-auth ConfigMap을 Access entry로 옮길 때의 대응 목록
+type AwsAuthMigration struct {
+	Entries []AccessEntryMigration `json:"entries"`
+	// Skipped Access entry로 옮길 수 없는 항목
+	Skipped []string `json:"skipped,omitempty"`
+}
+
+// MigrateAwsAuth aws-auth 매핑별로 같은 권한을 주는 Access entry 계산
+// - 노드 역할(system:nodes 그룹)은 EC2_LINUX/EC2_WINDOWS, Fargate 역할(system:node-proxier 그룹)은 FARGATE_LINUX 유형 (그룹은 EKS가 지정)
+// - system:masters 그룹은 Access entry에 지정할 수 없으므로 cluster 범위 AmazonEKSClusterAdminPolicy로 대체
+// - 와일드카드 ARN, 계정 전체 매핑(mapAccounts)은 Skipped로 분류
+func MigrateAwsAuth(auth AwsAuth, clusterName string) AwsAuthMigration {
+	migration := AwsAuthMigration{Entries: []AccessEntryMigration{}}
+	seen := map[string]bool{}
+
+	migrate := func(section string, m AwsAuthMapping, role bool) {
+		principal := m.ARN()
+		if principal == "" {
+			return
+		}
+		if strings.Contains(principal, "*") || isAccountRoot(principal) {
+			migration.Skipped = append(migration.Skipped, section+" "+principal+": 계정 전체/와일드카드 매핑은 Access entry로 옮길 수 없음, 필요한 IAM principal별로 생성")
+			return
+		}
+
+		entry := AccessEntryMigration{Source: section, PrincipalArn: principal, Type: accessEntryTypeStandard}
+		if role {
+			if base, ok := stripRolePath(principal); ok {
+				entry.PrincipalArn = base
+				entry.Notes = append(entry.Notes, "원본 ARN의 경로를 제외함, 실제 IAM 역할 ARN 확인 필요")
+			}
+		}
+		if seen[entry.PrincipalArn] {
+			migration.Skipped = append(migration.Skipped, section+" "+principal+": 같은 ARN의 앞선 매핑으로 대체 (중복/충돌 매핑)")
+			return
+		}
+		seen[entry.PrincipalArn] = true
+
+		switch {
+		case slices.Contains(m.Groups, "system:node-proxier"):
+			entry.Type = "FARGATE_LINUX"
+			entry.Notes = append(entry.Notes, "Fargate Pod 실행 역할은 그룹과 username을 EKS가 지정")
+		case slices.Contains(m.Groups, "eks:kube-proxy-windows"):
+			entry.Type = "EC2_WINDOWS"
+			entry.Notes = append(entry.Notes, "Windows 노드 역할은 그룹과 username을 EKS가 지정")
+		case slices.Contains(m.Groups, "system:nodes") || slices.Contains(m.Groups, "system:bootstrappers"):
+			entry.Type = "EC2_LINUX"
+			entry.Notes = append(entry.Notes, "노드 역할은 그룹과 username을 EKS가 지정")
+		default:
+			entry.Username = m.Username
+			for _, group := range m.Groups {
+				switch {
+				case group == "system:masters":
+					entry.AccessPolicies = append(entry.AccessPolicies, "arn:aws:eks::aws:cluster-access-policy/"+clusterAdminPolicyName)
+					entry.Notes = append(entry.Notes, "system:masters 그룹은 cluster 범위 "+clusterAdminPolicyName+"로 대체 (더 좁은 Access policy 검토 권장)")
+				case strings.HasPrefix(group, "system:"):
+					entry.Notes = append(entry.Notes, "Access entry에는 system: 그룹("+group+")을 지정할 수 없음")
+				default:
+					entry.KubernetesGroups = append(entry.KubernetesGroups, group)
+				}
+			}
+		}
+
+		command := fmt.Sprintf("aws eks create-access-entry --cluster-name %s --principal-arn %s --type %s", clusterName, entry.PrincipalArn, entry.Type)
+		if entry.Username != "" {
+			command += fmt.Sprintf(" --username '%s'", entry.Username)
+		}
+		if len(entry.KubernetesGroups) > 0 {
+			command += " --kubernetes-groups " + strings.Join(entry.KubernetesGroups, " ")
+		}
+		entry.Commands = append(entry.Commands, command)
+		for _, policy := range entry.AccessPolicies {
+			entry.Commands = append(entry.Commands, fmt.Sprintf("aws eks associate-access-policy --cluster-name %s --principal-arn %s --policy-arn %s --access-scope type=cluster", clusterName, entry.PrincipalArn, policy))
+		}
+		migration.Entries = append(migration.Entries, entry)
+	}
+
+	for _, m := range auth.Roles {
+		migrate("mapRoles", m, true)
+	}
+	for _, m := range auth.Users {
+		migrate("mapUsers", m, false)
+	}
+	for _, account := range auth.Accounts {
+		migration.Skipped = append(migration.Skipped, "mapAccounts "+account+": 계정 전체 매핑은 Access entry로 옮길 수 없음, 필요한 IAM principal별로 생성")
+	}
+	return migration
+}
+
+// stripRolePath 경로가 포함된 역할 ARN(role/path/name)을 경로를 제외한 ARN으로 변환
+func stripRolePath(principal string) (string, bool) {
+	parsed, err := arn.Parse(principal)
+	if err != nil || !strings.HasPrefix(parsed.Resource, "role/") {
+		return principal, false
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if len(parts) <= 2 {
+		return principal, false
+	}
+	parsed.Resource = "role/" + parts[len(parts)-1]
+	return parsed.String(), true
+}
+
+// isAccountRoot 계정 root ARN(arn:aws:iam::<계정>:root) 여부
+func isAccountRoot(principal string) bool {
+	parsed, err := arn.Parse(principal)
+	return err == nil && parsed.Resource == "root"
+}
+
+// isNodeMapping 노드 또는 Fargate Pod 실행 역할 매핑 여부
+func isNodeMapping(m AwsAuthMapping) bool {
+	for _, group := range nodeGroups {
+		if slices.Contains(m.Groups, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameGroups(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
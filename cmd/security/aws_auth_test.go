@@ -0,0 +1,66 @@
+package security_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+)
+
+func TestAwsAuth(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "aws_auth.yaml")
+
+	for _, tc := range testCases {
+		name := tc["name"].(string)
+
+		t.Run(name, func(t *testing.T) {
+			data := map[string]string{}
+			for key, value := range tc["data"].(map[string]interface{}) {
+				data[key] = value.(string)
+			}
+
+			auth, issues := security.ParseAwsAuth(data)
+			issues = append(issues, security.LintAwsAuth(auth)...)
+
+			expectIssues := testutils.ToStrings(tc["expect_issues"])
+			if len(issues) != len(expectIssues) {
+				t.Errorf("Test '%s' failed: expected %d issues, got %d: %v", name, len(expectIssues), len(issues), issues)
+			}
+			for _, expect := range expectIssues {
+				found := false
+				for _, issue := range issues {
+					if strings.HasPrefix(issue.String(), expect) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Test '%s' failed: expected issue starting with %q, got %v", name, expect, issues)
+				}
+			}
+
+			migration := security.MigrateAwsAuth(auth, "mock-cluster")
+			var entries []string
+			for _, entry := range migration.Entries {
+				summary := entry.PrincipalArn + " " + entry.Type
+				if entry.Type == "STANDARD" {
+					summary += fmt.Sprintf(" %s %v %v", entry.Username, entry.KubernetesGroups, entry.AccessPolicies)
+				}
+				entries = append(entries, summary)
+				if !strings.HasPrefix(entry.Commands[0], "aws eks create-access-entry --cluster-name mock-cluster --principal-arn "+entry.PrincipalArn) {
+					t.Errorf("Test '%s' failed: unexpected command %q", name, entry.Commands[0])
+				}
+				if len(entry.Commands) != 1+len(entry.AccessPolicies) {
+					t.Errorf("Test '%s' failed: expected associate-access-policy command per policy, got %v", name, entry.Commands)
+				}
+			}
+			if expect := testutils.ToStrings(tc["expect_entries"]); strings.Join(entries, "\n") != strings.Join(expect, "\n") {
+				t.Errorf("Test '%s' failed: expected entries %v, got %v", name, expect, entries)
+			}
+			if expect, ok := tc["expect_skipped"].(int); ok && len(migration.Skipped) != expect {
+				t.Errorf("Test '%s' failed: expected %d skipped, got %v", name, expect, migration.Skipped)
+			}
+		})
+	}
+}
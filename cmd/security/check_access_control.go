@@ -2,95 +2,283 @@ package security
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 )
 
-func CheckAccessControl(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+// clusterAdminPolicyName 클러스터 전체에 대한 관리자 권한을 주는 Access policy
+const clusterAdminPolicyName = "AmazonEKSClusterAdminPolicy"
+
+// accessEntryTypeStandard 사용자/역할에 Kubernetes 그룹을 지정하는 일반 Access entry 유형
+const accessEntryTypeStandard = "STANDARD"
+
+// AccessEntriesAPI SEC-002 점검에 사용하는 EKS API
+type AccessEntriesAPI interface {
+	eks.ListAccessEntriesAPIClient
+	eks.ListAssociatedAccessPoliciesAPIClient
+	DescribeAccessEntry(ctx context.Context, params *eks.DescribeAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+}
+
+func CheckAccessControl(ctx context.Context, client kubernetes.Interface, cfg aws.Config, cluster *types.Cluster, eksCluster string, allowlist []string, baseDir string) common.CheckResult {
+	return EvaluateAccessControl(ctx, client, eks.NewFromConfig(cfg), cluster, eksCluster, allowlist, baseDir)
+}
+
+// EvaluateAccessControl aws-auth ConfigMap 매핑과 Access entry, 연결된 Access policy, Kubernetes 그룹을 principal별로 평가
+// - aws-auth ConfigMap 파싱 실패 및 매핑 문제 (LintAwsAuth)
+// - cluster 범위 AmazonEKSClusterAdminPolicy가 연결된 principal 중 허용 목록(allowlist, *는 임의의 문자열)에 없는 principal
+// - STANDARD Access entry의 Kubernetes 그룹이 system:masters이거나 cluster-admin 수준 ClusterRole에 바인딩된 경우
+// - 클러스터 authenticationMode가 CONFIG_MAP인 경우 (Access entry를 사용할 수 없음)
+// aws-auth ConfigMap과 Access entry 원본, aws-auth의 Access entry 전환 보고서는 증적으로 baseDir에 저장
+func EvaluateAccessControl(ctx context.Context, client kubernetes.Interface, api AccessEntriesAPI, cluster *types.Cluster, eksCluster string, allowlist []string, baseDir string) common.CheckResult {
 	result := common.CheckResult{
-		CheckName:  "[SEC-002] 클러스터 접근 제어(Access entries, aws-auth 컨피그맵)",
-		Manual:     true,
-		Passed:     true,
-		FailureMsg: "클러스터 접근 제어 설정이 되어 있으나, 적합한 설정이 되어 있는지 수동으로 확인해야 합니다.",
-		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-002",
+		CheckName: "[SEC-002] 클러스터 접근 제어(Access entries, aws-auth 컨피그맵)",
+		Manual:    false,
+		Passed:    false,
+		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-002",
 	}
 
-	// 👉 실행 디렉토리 기준 ./result 하위 경로 생성
-	baseDir := filepath.Join(".", "output", eksCluster+"-access-control")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
-		result.Passed = false
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
 	// ---------------------------------------
-	// 1. aws-auth ConfigMap 저장
+	// 1. aws-auth ConfigMap 저장 및 매핑 점검
 	// ---------------------------------------
+	var evidence, findings []string
 	hasConfigMap := false
-	configMapClient := client.CoreV1().ConfigMaps("kube-system")
-	configMap, err := configMapClient.Get(context.TODO(), "aws-auth", v1.GetOptions{})
+	configMap, err := client.CoreV1().ConfigMaps("kube-system").Get(ctx, "aws-auth", v1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		result.FailureMsg = result.CheckName + " 검사 실패 : aws-auth ConfigMap 조회 실패: " + err.Error()
+		return result
+	}
 	if err == nil {
 		configMapPath := filepath.Join(baseDir, "aws-auth-configmap.yaml")
-		err = common.SaveK8sResourceAsYAML(configMap, configMapPath)
-		if err != nil {
-			result.Passed = false
+		if err := common.SaveK8sResourceAsYAML(configMap, configMapPath); err != nil {
 			result.FailureMsg = "aws-auth ConfigMap 저장 실패: " + err.Error()
 			return result
 		}
 		hasConfigMap = true
-		result.Resources = append(result.Resources, "aws-auth ConfigMap 저장 경로: "+configMapPath)
+		evidence = append(evidence, "aws-auth ConfigMap 저장 경로: "+configMapPath)
+
+		auth, issues := ParseAwsAuth(configMap.Data)
+		issues = append(issues, LintAwsAuth(auth)...)
+		for _, issue := range issues {
+			findings = append(findings, issue.String())
+		}
+
+		// aws-auth 매핑과 같은 권한을 주는 Access entry 목록 (Access entry 전환용)
+		migrationPath := filepath.Join(baseDir, "aws-auth-migration.json")
+		if err := common.SaveAsJSON(MigrateAwsAuth(auth, eksCluster), migrationPath); err != nil {
+			result.FailureMsg = "aws-auth 전환 보고서 저장 실패: " + err.Error()
+			return result
+		}
+		evidence = append(evidence, "aws-auth Access entry 전환 보고서 경로: "+migrationPath)
 	}
 
 	// ---------------------------------------
-	// 2. Access Entries 저장
+	// 2. 인증 모드 확인
 	// ---------------------------------------
-	hasAccessEntries := false
-	var accessEntries []interface{}
-
-	eksClient := eks.NewFromConfig(cfg)
+	mode := types.AuthenticationMode("")
+	if cluster != nil && cluster.AccessConfig != nil {
+		mode = cluster.AccessConfig.AuthenticationMode
+	}
+	if mode == types.AuthenticationModeConfigMap {
+		findings = append(findings, "클러스터 authenticationMode: CONFIG_MAP (Access entry를 사용할 수 없어 aws-auth ConfigMap으로만 접근을 관리)")
+	}
 
-	listResp, err := eksClient.ListAccessEntries(context.TODO(), &eks.ListAccessEntriesInput{
-		ClusterName: &eksCluster,
-	})
-	if err == nil && len(listResp.AccessEntries) > 0 {
-		for _, ae := range listResp.AccessEntries {
-			descResp, err := eksClient.DescribeAccessEntry(context.TODO(), &eks.DescribeAccessEntryInput{
-				PrincipalArn: &ae,
-				ClusterName:  &eksCluster,
-			})
-			if err == nil {
-				accessEntries = append(accessEntries, descResp.AccessEntry)
-			}
+	// ---------------------------------------
+	// 3. Access Entries 및 연결된 Access Policy 조회/저장
+	// CONFIG_MAP 모드에서는 Access entry API를 사용할 수 없으므로 조회하지 않음
+	// ---------------------------------------
+	var entries []types.AccessEntry
+	policies := map[string][]types.AssociatedAccessPolicy{}
+	if mode != types.AuthenticationModeConfigMap {
+		entries, policies, err = listAccessEntries(ctx, api, eksCluster)
+		if err != nil {
+			result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+			return result
 		}
 	}
 
-	if len(accessEntries) > 0 {
-		hasAccessEntries = true
+	if len(entries) > 0 {
 		accessEntryPath := filepath.Join(baseDir, "access-entries.json")
-		err := common.SaveAsJSON(accessEntries, accessEntryPath)
-		if err != nil {
-			result.Passed = false
+		if err := common.SaveAsJSON(entries, accessEntryPath); err != nil {
 			result.FailureMsg = "Access Entries 저장 실패: " + err.Error()
 			return result
 		}
-		result.Resources = append(result.Resources, "Access Entries 저장 경로: "+accessEntryPath)
+		accessPolicyPath := filepath.Join(baseDir, "access-policies.json")
+		if err := common.SaveAsJSON(policies, accessPolicyPath); err != nil {
+			result.FailureMsg = "Access Policy 저장 실패: " + err.Error()
+			return result
+		}
+		evidence = append(evidence, "Access Entries 저장 경로: "+accessEntryPath, "Access Policy 저장 경로: "+accessPolicyPath)
 	}
 
 	// ---------------------------------------
-	// 3. 최종 결과 판단
+	// 4. principal별 평가
 	// ---------------------------------------
-	if !hasConfigMap && !hasAccessEntries {
-		result.Manual = false
-		result.Passed = false
+	adminGroups, err := clusterAdminGroups(ctx, client)
+	if err != nil {
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+
+	for _, entry := range entries {
+		principal := aws.ToString(entry.PrincipalArn)
+
+		for _, policy := range policies[principal] {
+			if !strings.HasSuffix(aws.ToString(policy.PolicyArn), "/"+clusterAdminPolicyName) {
+				continue
+			}
+			if policy.AccessScope == nil || policy.AccessScope.Type != types.AccessScopeTypeCluster {
+				continue
+			}
+			if !matchesAllowlist(principal, allowlist) {
+				findings = append(findings, principal+": "+clusterAdminPolicyName+" (cluster 범위) 연결, 허용 목록에 없음")
+			}
+		}
+
+		if aws.ToString(entry.Type) != accessEntryTypeStandard {
+			continue
+		}
+		for _, group := range entry.KubernetesGroups {
+			if binding, ok := adminGroups[group]; ok {
+				findings = append(findings, fmt.Sprintf("%s: STANDARD Access entry의 Kubernetes 그룹 '%s'이(가) cluster-admin 권한 보유 (%s)", principal, group, binding))
+			}
+		}
+	}
+
+	// ---------------------------------------
+	// 5. 최종 결과 판단
+	// ---------------------------------------
+	switch {
+	case len(findings) > 0:
+		result.FailureMsg = fmt.Sprintf("클러스터 접근 제어에서 과도한 권한 또는 권장하지 않는 설정 %d건이 발견되었습니다.", len(findings))
+		result.Resources = append(findings, evidence...)
+	case !hasConfigMap && len(entries) == 0:
 		result.FailureMsg = "aws-auth ConfigMap과 Access Entries 설정이 모두 존재하지 않습니다."
+	default:
+		result.Passed = true
+		result.Resources = evidence
 	}
 
 	return result
 }
+
+// listAccessEntries Access entry 상세 정보와 principal별로 연결된 Access policy 조회
+func listAccessEntries(ctx context.Context, api AccessEntriesAPI, eksCluster string) ([]types.AccessEntry, map[string][]types.AssociatedAccessPolicy, error) {
+	var principalArns []string
+	paginator := eks.NewListAccessEntriesPaginator(api, &eks.ListAccessEntriesInput{
+		ClusterName: aws.String(eksCluster),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Access entry 목록 조회 실패: %w", err)
+		}
+		principalArns = append(principalArns, page.AccessEntries...)
+	}
+
+	var entries []types.AccessEntry
+	policies := map[string][]types.AssociatedAccessPolicy{}
+	for _, principal := range principalArns {
+		desc, err := api.DescribeAccessEntry(ctx, &eks.DescribeAccessEntryInput{
+			ClusterName:  aws.String(eksCluster),
+			PrincipalArn: aws.String(principal),
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("Access entry 조회 실패 (%s): %w", principal, err)
+		}
+		if desc.AccessEntry != nil {
+			entries = append(entries, *desc.AccessEntry)
+		}
+
+		associated := []types.AssociatedAccessPolicy{}
+		policyPaginator := eks.NewListAssociatedAccessPoliciesPaginator(api, &eks.ListAssociatedAccessPoliciesInput{
+			ClusterName:  aws.String(eksCluster),
+			PrincipalArn: aws.String(principal),
+		})
+		for policyPaginator.HasMorePages() {
+			page, err := policyPaginator.NextPage(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("Access policy 조회 실패 (%s): %w", principal, err)
+			}
+			associated = append(associated, page.AssociatedAccessPolicies...)
+		}
+		policies[principal] = associated
+	}
+	return entries, policies, nil
+}
+
+// clusterAdminGroups cluster-admin 수준 권한을 가진 Kubernetes 그룹과 권한 경로
+// system:masters와 cluster-admin 수준 ClusterRole(모든 API 그룹/리소스/동작 허용)에 ClusterRoleBinding으로 연결된 그룹
+func clusterAdminGroups(ctx context.Context, client kubernetes.Interface) (map[string]string, error) {
+	groups := map[string]string{"system:masters": "system:masters 그룹"}
+
+	roles, err := kube.ListAll(ctx, client.RbacV1().ClusterRoles().List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ClusterRole 목록 조회 실패: %w", err)
+	}
+	adminRoles := map[string]bool{"cluster-admin": true}
+	for _, role := range roles.Items {
+		if isClusterAdminRole(role) {
+			adminRoles[role.Name] = true
+		}
+	}
+
+	bindings, err := kube.ListAll(ctx, client.RbacV1().ClusterRoleBindings().List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ClusterRoleBinding 목록 조회 실패: %w", err)
+	}
+	for _, binding := range bindings.Items {
+		if binding.RoleRef.Kind != "ClusterRole" || !adminRoles[binding.RoleRef.Name] {
+			continue
+		}
+		for _, subject := range binding.Subjects {
+			if subject.Kind != rbacv1.GroupKind {
+				continue
+			}
+			if _, exists := groups[subject.Name]; !exists {
+				groups[subject.Name] = "ClusterRoleBinding/" + binding.Name + " → ClusterRole/" + binding.RoleRef.Name
+			}
+		}
+	}
+	return groups, nil
+}
+
+// isClusterAdminRole 모든 API 그룹의 모든 리소스에 모든 동작을 허용하는 ClusterRole인지 여부
+func isClusterAdminRole(role rbacv1.ClusterRole) bool {
+	for _, rule := range role.Rules {
+		if slices.Contains(rule.Verbs, "*") && slices.Contains(rule.Resources, "*") && slices.Contains(rule.APIGroups, "*") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAllowlist principal ARN이 허용 목록 패턴 중 하나와 일치하는지 여부 (*는 '/'를 포함한 임의의 문자열)
+func matchesAllowlist(principal string, allowlist []string) bool {
+	for _, pattern := range allowlist {
+		expr := "^" + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, ".*") + "$"
+		if regexp.MustCompile(expr).MatchString(principal) {
+			return true
+		}
+	}
+	return false
+}
@@ -2,17 +2,70 @@ package security_test
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"testing"
 
 	"eks-checklist/cmd/security"
 	"eks-checklist/cmd/testutils"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 )
 
+func toMaps(v interface{}) []map[string]interface{} {
+	var result []map[string]interface{}
+	if v == nil {
+		return result
+	}
+	for _, item := range v.([]interface{}) {
+		result = append(result, item.(map[string]interface{}))
+	}
+	return result
+}
+
+// fakeAccessEntriesAPI Access entry와 연결된 Access policy를 돌려주는 EKS API
+type fakeAccessEntriesAPI struct {
+	entries  []types.AccessEntry
+	policies map[string][]types.AssociatedAccessPolicy
+	err      error
+}
+
+func (f fakeAccessEntriesAPI) ListAccessEntries(ctx context.Context, input *eks.ListAccessEntriesInput, optFns ...func(*eks.Options)) (*eks.ListAccessEntriesOutput, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	output := &eks.ListAccessEntriesOutput{}
+	for _, entry := range f.entries {
+		output.AccessEntries = append(output.AccessEntries, aws.ToString(entry.PrincipalArn))
+	}
+	return output, nil
+}
+
+func (f fakeAccessEntriesAPI) DescribeAccessEntry(ctx context.Context, input *eks.DescribeAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	for _, entry := range f.entries {
+		if aws.ToString(entry.PrincipalArn) == aws.ToString(input.PrincipalArn) {
+			return &eks.DescribeAccessEntryOutput{AccessEntry: &entry}, nil
+		}
+	}
+	return nil, fmt.Errorf("ResourceNotFoundException")
+}
+
+func (f fakeAccessEntriesAPI) ListAssociatedAccessPolicies(ctx context.Context, input *eks.ListAssociatedAccessPoliciesInput, optFns ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	return &eks.ListAssociatedAccessPoliciesOutput{
+		AssociatedAccessPolicies: f.policies[aws.ToString(input.PrincipalArn)],
+	}, nil
+}
+
 func TestCheckAccessControl(t *testing.T) {
 	testCases := testutils.LoadTestCases(t, "access_control.yaml")
 
@@ -23,8 +76,8 @@ func TestCheckAccessControl(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			client := fake.NewSimpleClientset()
 
-			// aws-auth ConfigMap 생성: AwsAuth_Missing 케이스가 아닌 경우 생성
-			if name != "AwsAuth_Missing" {
+			// aws-auth ConfigMap 생성: aws_auth가 지정된 케이스만 생성
+			if data, ok := tc["aws_auth"].(map[string]interface{}); ok {
 				configMap := &corev1.ConfigMap{
 					ObjectMeta: v1.ObjectMeta{
 						Name:      "aws-auth",
@@ -32,12 +85,8 @@ func TestCheckAccessControl(t *testing.T) {
 					},
 					Data: map[string]string{},
 				}
-
-				// 특정 케이스에 대해 추가 데이터를 설정
-				if name == "AwsAuth_With_Roles_Users_Accounts" {
-					configMap.Data["mapRoles"] = "- rolearn: arn:aws:iam::123456789012:role/EKSAdmin\n  username: admin"
-					configMap.Data["mapUsers"] = "- userarn: arn:aws:iam::123456789012:user/john\n  username: john"
-					configMap.Data["mapAccounts"] = "- 123456789012"
+				for key, value := range data {
+					configMap.Data[key] = value.(string)
 				}
 
 				_, err := client.CoreV1().ConfigMaps("kube-system").Create(context.TODO(), configMap, v1.CreateOptions{})
@@ -46,14 +95,76 @@ func TestCheckAccessControl(t *testing.T) {
 				}
 			}
 
-			// Dummy aws.Config 생성 (테스트에서는 별도의 설정 없이 사용)
-			var cfg aws.Config
+			// aws-auth ConfigMap 조회 오류: configmap_error가 지정된 케이스만 Forbidden 반환
+			if msg, ok := tc["configmap_error"].(string); ok {
+				client.PrependReactor("get", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "aws-auth", fmt.Errorf("%s", msg))
+				})
+			}
+
+			for _, role := range toMaps(tc["cluster_roles"]) {
+				rule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list"}}
+				if role["wildcard"].(bool) {
+					rule = rbacv1.PolicyRule{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}
+				}
+				_, err := client.RbacV1().ClusterRoles().Create(context.TODO(), &rbacv1.ClusterRole{
+					ObjectMeta: v1.ObjectMeta{Name: role["name"].(string)},
+					Rules:      []rbacv1.PolicyRule{rule},
+				}, v1.CreateOptions{})
+				if err != nil {
+					t.Fatalf("ClusterRole 생성 실패: %v", err)
+				}
+			}
+
+			for _, binding := range toMaps(tc["cluster_role_bindings"]) {
+				crb := &rbacv1.ClusterRoleBinding{
+					ObjectMeta: v1.ObjectMeta{Name: binding["name"].(string)},
+					RoleRef:    rbacv1.RoleRef{Kind: "ClusterRole", Name: binding["role"].(string)},
+				}
+				for _, group := range testutils.ToStrings(binding["groups"]) {
+					crb.Subjects = append(crb.Subjects, rbacv1.Subject{Kind: rbacv1.GroupKind, Name: group})
+				}
+				if _, err := client.RbacV1().ClusterRoleBindings().Create(context.TODO(), crb, v1.CreateOptions{}); err != nil {
+					t.Fatalf("ClusterRoleBinding 생성 실패: %v", err)
+				}
+			}
+
+			api := fakeAccessEntriesAPI{policies: map[string][]types.AssociatedAccessPolicy{}}
+			if msg, ok := tc["api_error"].(string); ok {
+				api.err = fmt.Errorf("%s", msg)
+			}
+			for _, entry := range toMaps(tc["entries"]) {
+				principal := entry["principal"].(string)
+				api.entries = append(api.entries, types.AccessEntry{
+					PrincipalArn:     aws.String(principal),
+					Type:             aws.String(entry["type"].(string)),
+					KubernetesGroups: testutils.ToStrings(entry["groups"]),
+				})
+				for _, policy := range toMaps(entry["policies"]) {
+					api.policies[principal] = append(api.policies[principal], types.AssociatedAccessPolicy{
+						PolicyArn:   aws.String(policy["arn"].(string)),
+						AccessScope: &types.AccessScope{Type: types.AccessScopeType(policy["scope"].(string))},
+					})
+				}
+			}
 
-			// 실제 호출: security.PrintAccessControl이 아니라 CheckAccessControl을 사용합니다.
-			result := security.CheckAccessControl(client, cfg, "mock-cluster")
+			var cluster *types.Cluster
+			if mode, ok := tc["authentication_mode"].(string); ok {
+				cluster = &types.Cluster{AccessConfig: &types.AccessConfigResponse{AuthenticationMode: types.AuthenticationMode(mode)}}
+			}
 
-			if result.Passed != expectPass {
-				t.Errorf("Test '%s' failed: expected %v, got %v", name, expectPass, result.Passed)
+			result := security.EvaluateAccessControl(context.Background(), client, api, cluster, "mock-cluster", testutils.ToStrings(tc["allowlist"]), t.TempDir())
+
+			testutils.AssertCheckResult(t, tc, expectPass, result)
+			if result.Manual {
+				t.Errorf("Test '%s' failed: expected automatic result", name)
+			}
+
+			resources := strings.Join(result.Resources, "\n")
+			for _, expect := range testutils.ToStrings(tc["expect_findings"]) {
+				if !strings.Contains(resources, expect) {
+					t.Errorf("Test '%s' failed: expected finding containing %q, got %v", name, expect, result.Resources)
+				}
 			}
 		})
 	}
@@ -6,13 +6,23 @@ import (
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
+// 검사에서 제외할 문자열
+var containerUserExcludeStrings = []string{
+	"aws-node",
+	"coredns",
+	"eks-pod-identity-agent",
+	"kube-proxy",
+}
+
 // CheckContainerExecutionUser checks if any container is running as root (UID 0).
-func CheckContainerExecutionUser(client kubernetes.Interface) common.CheckResult {
+func CheckContainerExecutionUser(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-005] 루트 유저가 아닌 유저로 컨테이너 실행",
 		Manual:     false,
@@ -21,15 +31,7 @@ func CheckContainerExecutionUser(client kubernetes.Interface) common.CheckResult
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-005",
 	}
 
-	// 검사에서 제외할 문자열
-	excludeStrings := []string{
-		"aws-node",
-		"coredns",
-		"eks-pod-identity-agent",
-		"kube-proxy",
-	}
-
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -37,47 +39,54 @@ func CheckContainerExecutionUser(client kubernetes.Interface) common.CheckResult
 	}
 
 	for _, pod := range pods.Items {
-		exclude := false
-		for _, excludeString := range excludeStrings {
-			if strings.Contains(pod.Name, excludeString) {
-				exclude = true
-				break
-			}
-		}
-		// pods label에 k8s-app 키가 있는 경우에도 패스
-		if _, exists := pod.Labels["k8s-app"]; exists {
-			exclude = true
+		if IsExcludedFromContainerUserCheck(pod.Name, pod.Labels) {
+			continue
 		}
 
-		// pods label에 app.kubernetes.io/managed-by 키가 있는 경우에도 패스
-		if _, exists := pod.Labels["app.kubernetes.io/managed-by"]; exists {
-			exclude = true
+		for _, violation := range ContainerUserViolations(pod.Spec) {
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Namespace: %s | Pod: %s | %s", pod.Namespace, pod.Name, violation))
 		}
+	}
 
-		if exclude {
-			continue
+	return result
+}
+
+// IsExcludedFromContainerUserCheck 시스템 컴포넌트나 관리 도구로 배포된 워크로드인지 판단
+func IsExcludedFromContainerUserCheck(name string, labels map[string]string) bool {
+	for _, excludeString := range containerUserExcludeStrings {
+		if strings.Contains(name, excludeString) {
+			return true
 		}
+	}
+	// pods label에 k8s-app 키가 있는 경우에도 패스
+	if _, exists := labels["k8s-app"]; exists {
+		return true
+	}
+
+	// pods label에 app.kubernetes.io/managed-by 키가 있는 경우에도 패스
+	if _, exists := labels["app.kubernetes.io/managed-by"]; exists {
+		return true
+	}
+
+	return false
+}
 
-		for _, container := range pod.Spec.Containers {
-			if container.SecurityContext != nil && container.SecurityContext.RunAsUser != nil {
-				if *container.SecurityContext.RunAsUser == 0 {
-					result.Passed = false
-					resource := fmt.Sprintf("Namespace: %s | Pod: %s | Container: %s (명시적 root 계정 실행)", pod.Namespace, pod.Name, container.Name)
-					result.Resources = append(result.Resources, resource)
-				} else if container.SecurityContext.WindowsOptions != nil && container.SecurityContext.WindowsOptions.RunAsUserName != nil {
-					if *container.SecurityContext.WindowsOptions.RunAsUserName == "Administrator" {
-						result.Passed = false
-						resource := fmt.Sprintf("Namespace: %s | Pod: %s | Container: %s (Windows Administrator 실행)", pod.Namespace, pod.Name, container.Name)
-						result.Resources = append(result.Resources, resource)
-					}
+// ContainerUserViolations PodSpec에서 root 유저로 실행되거나 실행될 수 있는 컨테이너를 찾아 반환
+func ContainerUserViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	for _, container := range spec.Containers {
+		if container.SecurityContext != nil && container.SecurityContext.RunAsUser != nil {
+			if *container.SecurityContext.RunAsUser == 0 {
+				violations = append(violations, fmt.Sprintf("Container: %s (명시적 root 계정 실행)", container.Name))
+			} else if container.SecurityContext.WindowsOptions != nil && container.SecurityContext.WindowsOptions.RunAsUserName != nil {
+				if *container.SecurityContext.WindowsOptions.RunAsUserName == "Administrator" {
+					violations = append(violations, fmt.Sprintf("Container: %s (Windows Administrator 실행)", container.Name))
 				}
-			} else {
-				result.Passed = false
-				resource := fmt.Sprintf("Namespace: %s | Pod: %s | Container: %s (RunAsUser 미설정, root로 실행 가능성 존재)", pod.Namespace, pod.Name, container.Name)
-				result.Resources = append(result.Resources, resource)
 			}
+		} else {
+			violations = append(violations, fmt.Sprintf("Container: %s (RunAsUser 미설정, root로 실행 가능성 존재)", container.Name))
 		}
 	}
-
-	return result
+	return violations
 }
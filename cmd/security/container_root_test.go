@@ -96,7 +96,7 @@ func TestCheckContainerExecutionUser(t *testing.T) {
 			}
 
 			// security 패키지의 CheckContainerExecutionUser 함수 실행
-			result := security.CheckContainerExecutionUser(client)
+			result := security.CheckContainerExecutionUser(context.Background(), client)
 
 			// 함수 결과와 YAML의 기대값(expect_failure)이 일치하는지 검증합니다.
 			// (expect_failure가 true면 루트 사용자가 감지되어야 하므로 result도 true여야 함)
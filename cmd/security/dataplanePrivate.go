@@ -11,10 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	eksTypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 )
 
 // DataplanePrivateCheck checks whether all subnets used by the EKS data plane are private.
-func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckResult {
+func DataplanePrivateCheck(ctx context.Context, eksCluster EksCluster, cfg aws.Config) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-012] 데이터 플레인 사설망",
 		Manual:     false,
@@ -26,22 +27,27 @@ func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckRe
 	eksClient := eks.NewFromConfig(cfg)
 	ec2Client := ec2.NewFromConfig(cfg)
 
-	// 노드 그룹 목록 조회
-	nodeGroupsOutput, err := eksClient.ListNodegroups(context.TODO(), &eks.ListNodegroupsInput{
+	// 노드 그룹 목록 조회 (페이지 단위 조회)
+	var nodeGroupNames []string
+	nodeGroupPaginator := eks.NewListNodegroupsPaginator(eksClient, &eks.ListNodegroupsInput{
 		ClusterName: aws.String(*eksCluster.Cluster.Name),
 	})
-	if err != nil {
-		result.Passed = false
-		result.FailureMsg = fmt.Sprintf("노드 그룹 목록 조회 실패: %v", err)
-		return result
+	for nodeGroupPaginator.HasMorePages() {
+		page, err := nodeGroupPaginator.NextPage(ctx)
+		if err != nil {
+			result.Passed = false
+			result.FailureMsg = fmt.Sprintf("노드 그룹 목록 조회 실패: %v", err)
+			return result
+		}
+		nodeGroupNames = append(nodeGroupNames, page.Nodegroups...)
 	}
 
 	subnetIDSet := make(map[string]struct{})
 	var vpcID string
 
 	// 각 노드 그룹의 서브넷 ID 수집
-	for _, nodeGroupName := range nodeGroupsOutput.Nodegroups {
-		nodeGroupOutput, err := eksClient.DescribeNodegroup(context.TODO(), &eks.DescribeNodegroupInput{
+	for _, nodeGroupName := range nodeGroupNames {
+		nodeGroupOutput, err := eksClient.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{
 			ClusterName:   aws.String(*eksCluster.Cluster.Name),
 			NodegroupName: aws.String(nodeGroupName),
 		})
@@ -67,8 +73,9 @@ func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckRe
 		vpcID = *eksCluster.Cluster.ResourcesVpcConfig.VpcId
 	}
 
-	// VPC의 모든 라우트 테이블 조회
-	rtOut, err := ec2Client.DescribeRouteTables(context.TODO(), &ec2.DescribeRouteTablesInput{
+	// VPC의 모든 라우트 테이블 조회 (페이지 단위 조회)
+	var routeTables []ec2types.RouteTable
+	routeTablePaginator := ec2.NewDescribeRouteTablesPaginator(ec2Client, &ec2.DescribeRouteTablesInput{
 		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
@@ -76,16 +83,34 @@ func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckRe
 			},
 		},
 	})
-	if err != nil {
+	for routeTablePaginator.HasMorePages() {
+		page, err := routeTablePaginator.NextPage(ctx)
+		if err != nil {
+			result.Passed = false
+			result.FailureMsg = fmt.Sprintf("라우트 테이블 조회 실패: %v", err)
+			return result
+		}
+		routeTables = append(routeTables, page.RouteTables...)
+	}
+
+	publicSubnets := PublicSubnets(subnetIDs, routeTables)
+
+	if len(publicSubnets) > 0 {
 		result.Passed = false
-		result.FailureMsg = fmt.Sprintf("라우트 테이블 조회 실패: %v", err)
-		return result
+		for _, subnet := range publicSubnets {
+			result.Resources = append(result.Resources, fmt.Sprintf("Public Subnet: %s", subnet))
+		}
 	}
 
+	return result
+}
+
+// PublicSubnets 라우트 테이블 기준으로 IGW로 향하는 0.0.0.0/0 경로가 있는 서브넷을 반환
+func PublicSubnets(subnetIDs []string, routeTables []ec2types.RouteTable) []string {
 	// 서브넷 ID -> 연결된 라우트 테이블 매핑
 	subnetToRouteTable := make(map[string]ec2types.RouteTable)
 
-	for _, rt := range rtOut.RouteTables {
+	for _, rt := range routeTables {
 		for _, assoc := range rt.Associations {
 			if assoc.SubnetId != nil {
 				subnetToRouteTable[*assoc.SubnetId] = rt
@@ -100,7 +125,7 @@ func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckRe
 
 		// 서브넷에 직접 연결된 라우트 테이블이 없으면, 메인 라우트 테이블 사용
 		if !exists {
-			for _, rtCandidate := range rtOut.RouteTables {
+			for _, rtCandidate := range routeTables {
 				for _, assoc := range rtCandidate.Associations {
 					if assoc.Main != nil && *assoc.Main {
 						rt = rtCandidate
@@ -116,24 +141,61 @@ func DataplanePrivateCheck(eksCluster EksCluster, cfg aws.Config) common.CheckRe
 
 		// IGW로 가는 0.0.0.0/0 경로가 있는지 확인
 		for _, route := range rt.Routes {
-			if route.DestinationCidrBlock != nil && *route.DestinationCidrBlock == "0.0.0.0/0" {
-				if route.GatewayId != nil {
-					if strings.HasPrefix(*route.GatewayId, "igw-") {
-						publicSubnets = append(publicSubnets, subnetID)
-						break
-					}
-				} else {
-					fmt.Printf("서브넷 %s의 0.0.0.0/0 경로에 GatewayId가 없습니다.\n", subnetID)
-				}
+			// GatewayId가 없는 경로(NAT 게이트웨이, Transit Gateway 등)는 IGW로 향하지 않으므로 사설 경로
+			if route.DestinationCidrBlock != nil && *route.DestinationCidrBlock == "0.0.0.0/0" &&
+				route.GatewayId != nil && strings.HasPrefix(*route.GatewayId, "igw-") {
+				publicSubnets = append(publicSubnets, subnetID)
+				break
 			}
 		}
 	}
 
-	if len(publicSubnets) > 0 {
+	return publicSubnets
+}
+
+// CheckNodegroupSubnetsPrivate 노드 그룹 구성과 서브넷 공개 여부(IaC 등에서 확인한 값)로 데이터 플레인 사설망 여부 확인
+// publicSubnets에 없는 서브넷은 공개 여부를 알 수 없는 것으로 보고 수동 확인 대상으로 분류
+func CheckNodegroupSubnetsPrivate(nodegroups []eksTypes.Nodegroup, publicSubnets map[string]bool) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-012] 데이터 플레인 사설망",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "일부 서브넷이 IGW(인터넷 게이트웨이)와 연결되어 있어 퍼블릭 상태입니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-012",
+	}
+
+	if len(nodegroups) == 0 {
 		result.Passed = false
-		for _, subnet := range publicSubnets {
-			result.Resources = append(result.Resources, fmt.Sprintf("Public Subnet: %s", subnet))
+		result.Manual = true
+		result.FailureMsg = "노드 그룹 구성을 찾을 수 없습니다."
+		return result
+	}
+
+	var unknown []string
+	for _, ng := range nodegroups {
+		name := aws.ToString(ng.NodegroupName)
+		if len(ng.Subnets) == 0 {
+			unknown = append(unknown, fmt.Sprintf("Nodegroup: %s (서브넷 미확정)", name))
+			continue
 		}
+		for _, subnetID := range ng.Subnets {
+			public, known := publicSubnets[subnetID]
+			switch {
+			case !known:
+				unknown = append(unknown, fmt.Sprintf("Nodegroup: %s | Subnet: %s (공개 여부 확인 불가)", name, subnetID))
+			case public:
+				result.Passed = false
+				result.Resources = append(result.Resources, fmt.Sprintf("Nodegroup: %s | Public Subnet: %s", name, subnetID))
+			}
+		}
+	}
+
+	// 퍼블릭 서브넷이 확인되면 실패, 일부만 확인 불가하면 수동 확인
+	if result.Passed && len(unknown) > 0 {
+		result.Passed = false
+		result.Manual = true
+		result.FailureMsg = "노드 그룹 서브넷의 공개 여부를 구성 정보만으로 확인할 수 없어 수동 확인이 필요합니다."
+		result.Resources = unknown
 	}
 
 	return result
@@ -140,7 +140,7 @@ func TestDataplanePrivateCheck(t *testing.T) {
 			defer p5.Unpatch()
 
 			// ── 9) 실제 함수 호출 및 검증
-			got := security.DataplanePrivateCheck(eksCluster, aws.Config{})
+			got := security.DataplanePrivateCheck(context.Background(), eksCluster, aws.Config{})
 			if got.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, got.Passed)
 			}
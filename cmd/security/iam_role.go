@@ -0,0 +1,132 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// IAMRoleAPI IAM 역할과 역할에 연결된 정책 문서를 조회하는 IAM API
+type IAMRoleAPI interface {
+	iam.ListAttachedRolePoliciesAPIClient
+	iam.ListRolePoliciesAPIClient
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	GetRolePolicy(ctx context.Context, params *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+}
+
+// RolePolicy 역할에 연결된 정책 (관리형 정책은 기본 버전 문서)
+type RolePolicy struct {
+	Name     string
+	Arn      string // 인라인 정책은 빈 문자열
+	Document *PolicyDocument
+}
+
+// roleNameFromArn 역할 ARN에서 역할 이름 추출 (arn:aws:iam::<계정>:role/<경로>/<이름>)
+func roleNameFromArn(roleArn string) string {
+	return roleArn[strings.LastIndex(roleArn, "/")+1:]
+}
+
+// isAWSManagedPolicy AWS 관리형 정책 ARN 여부 (arn:<파티션>:iam::aws:policy/<경로>/<이름>, aws-cn, aws-us-gov 등 파티션 무관)
+func isAWSManagedPolicy(policyArn string) bool {
+	parsed, err := arn.Parse(policyArn)
+	return err == nil && parsed.Service == "iam" && parsed.AccountID == "aws" && strings.HasPrefix(parsed.Resource, "policy/")
+}
+
+// isNoSuchEntity IAM 리소스가 존재하지 않는 오류 여부
+func isNoSuchEntity(err error) bool {
+	var notFound *iamtypes.NoSuchEntityException
+	return errors.As(err, &notFound)
+}
+
+// getRole 역할 조회 (역할이 없으면 nil, nil)
+func getRole(ctx context.Context, api IAMRoleAPI, roleName string) (*iamtypes.Role, error) {
+	output, err := api.GetRole(ctx, &iam.GetRoleInput{RoleName: aws.String(roleName)})
+	if err != nil {
+		if isNoSuchEntity(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("역할 %s 조회 실패: %w", roleName, err)
+	}
+	return output.Role, nil
+}
+
+// listRolePolicies 역할에 연결된 관리형 정책(기본 버전)과 인라인 정책 문서 조회
+func listRolePolicies(ctx context.Context, api IAMRoleAPI, roleName string) ([]RolePolicy, error) {
+	var policies []RolePolicy
+
+	attached := iam.NewListAttachedRolePoliciesPaginator(api, &iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)})
+	for attached.HasMorePages() {
+		page, err := attached.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("역할 %s 연결 정책 조회 실패: %w", roleName, err)
+		}
+		for _, p := range page.AttachedPolicies {
+			document, err := managedPolicyDocument(ctx, api, aws.ToString(p.PolicyArn))
+			if err != nil {
+				return nil, err
+			}
+			policies = append(policies, RolePolicy{Name: aws.ToString(p.PolicyName), Arn: aws.ToString(p.PolicyArn), Document: document})
+		}
+	}
+
+	inline := iam.NewListRolePoliciesPaginator(api, &iam.ListRolePoliciesInput{RoleName: aws.String(roleName)})
+	for inline.HasMorePages() {
+		page, err := inline.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("역할 %s 인라인 정책 조회 실패: %w", roleName, err)
+		}
+		for _, name := range page.PolicyNames {
+			output, err := api.GetRolePolicy(ctx, &iam.GetRolePolicyInput{RoleName: aws.String(roleName), PolicyName: aws.String(name)})
+			if err != nil {
+				return nil, fmt.Errorf("역할 %s 인라인 정책 %s 조회 실패: %w", roleName, name, err)
+			}
+			document, err := ParsePolicyDocument(aws.ToString(output.PolicyDocument))
+			if err != nil {
+				return nil, fmt.Errorf("인라인 정책 %s: %w", name, err)
+			}
+			policies = append(policies, RolePolicy{Name: name, Document: document})
+		}
+	}
+	return policies, nil
+}
+
+// managedPolicyDocument 관리형 정책의 기본 버전 문서 조회
+func managedPolicyDocument(ctx context.Context, api IAMRoleAPI, policyArn string) (*PolicyDocument, error) {
+	policy, err := api.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: aws.String(policyArn)})
+	if err != nil {
+		return nil, fmt.Errorf("정책 %s 조회 실패: %w", policyArn, err)
+	}
+	version, err := api.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{PolicyArn: aws.String(policyArn), VersionId: policy.Policy.DefaultVersionId})
+	if err != nil {
+		return nil, fmt.Errorf("정책 %s 버전 조회 실패: %w", policyArn, err)
+	}
+	document, err := ParsePolicyDocument(aws.ToString(version.PolicyVersion.Document))
+	if err != nil {
+		return nil, fmt.Errorf("정책 %s: %w", policyArn, err)
+	}
+	return document, nil
+}
+
+// allowsFullAccess 모든 리소스에 대해 모든 동작(* 또는 *:*)을 허용하는 문장 여부
+func allowsFullAccess(statement PolicyStatement) bool {
+	return statement.Effect == "Allow" &&
+		(slices.Contains(statement.Action, "*") || slices.Contains(statement.Action, "*:*")) &&
+		slices.Contains(statement.Resource, "*")
+}
+
+// statementLabel 결과 표시용 문장 이름 (Sid가 없으면 순번)
+func statementLabel(statement PolicyStatement, index int) string {
+	if statement.Sid != "" {
+		return statement.Sid
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
@@ -0,0 +1,254 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ec2NodeClassGVR Karpenter EC2NodeClass
+var ec2NodeClassGVR = schema.GroupVersionResource{Group: "karpenter.k8s.aws", Version: "v1", Resource: "ec2nodeclasses"}
+
+// IMDSAPI SEC-018 점검에 사용하는 EC2 API
+type IMDSAPI interface {
+	ec2.DescribeInstancesAPIClient
+	ec2.DescribeLaunchTemplateVersionsAPIClient
+}
+
+// NodegroupAPI 관리형 노드 그룹 조회에 사용하는 EKS API
+type NodegroupAPI interface {
+	eks.ListNodegroupsAPIClient
+	DescribeNodegroup(ctx context.Context, params *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error)
+}
+
+func CheckIMDSHardening(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, cfg aws.Config, eksCluster EksCluster) common.CheckResult {
+	return EvaluateIMDSHardening(ctx, client, dynamicClient, ec2.NewFromConfig(cfg), eks.NewFromConfig(cfg), eksCluster)
+}
+
+// EvaluateIMDSHardening 노드에서 Pod가 인스턴스 메타데이터(IMDS)로 노드 자격 증명을 얻을 수 있는지 확인
+// - 노드 EC2 인스턴스(spec.providerID), 관리형 노드 그룹 시작 템플릿, Karpenter EC2NodeClass의 메타데이터 옵션 (HttpTokens가 required가 아니면 IMDSv1 허용, HttpPutResponseHopLimit이 1보다 크면 hostNetwork가 아닌 Pod에서도 IMDS 접근 가능)
+// - hostNetwork Pod는 hop limit과 관계없이 IMDS에 접근할 수 있으므로 별도로 표시 (kube-system 네임스페이스는 검사 제외)
+// IMDS 엔드포인트를 비활성화(HttpEndpoint disabled)한 경우는 제외
+func EvaluateIMDSHardening(ctx context.Context, client kubernetes.Interface, dynamicClient dynamic.Interface, ec2API IMDSAPI, eksAPI NodegroupAPI, eksCluster EksCluster) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-018] 인스턴스 메타데이터(IMDS) 접근 제한",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "일부 노드에서 Pod가 인스턴스 메타데이터(IMDS)로 노드 자격 증명을 얻을 수 있습니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-018",
+	}
+	fail := func(err error) common.CheckResult {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	if eksCluster.Cluster == nil {
+		return fail(errNoCluster)
+	}
+
+	// 1. 노드 EC2 인스턴스
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
+	if err != nil {
+		return fail(err)
+	}
+	instances, _, err := describeNodeInstances(ctx, ec2API, nodes.Items)
+	if err != nil {
+		return fail(err)
+	}
+	imdsEnabled := map[string]bool{} // 노드 이름 → IMDS 엔드포인트 활성화 여부
+	var problemOrder []string
+	nodesByProblem := map[string][]string{}
+	for _, instance := range instances {
+		if instance.instance == nil || instance.instance.MetadataOptions == nil {
+			continue
+		}
+		options := instance.instance.MetadataOptions
+		for _, node := range instance.nodes {
+			imdsEnabled[node] = options.HttpEndpoint != "disabled"
+		}
+		for _, problem := range imdsProblems(string(options.HttpEndpoint), string(options.HttpTokens), options.HttpPutResponseHopLimit) {
+			if _, ok := nodesByProblem[problem]; !ok {
+				problemOrder = append(problemOrder, problem)
+			}
+			nodesByProblem[problem] = append(nodesByProblem[problem], instance.nodes...)
+		}
+	}
+	for _, problem := range problemOrder {
+		result.Resources = append(result.Resources, "Node: "+summarizeNames(nodesByProblem[problem], 3)+" | "+problem)
+	}
+
+	// 2. 관리형 노드 그룹 시작 템플릿
+	nodegroups, err := listNodegroups(ctx, eksAPI, aws.ToString(eksCluster.Cluster.Name))
+	if err != nil {
+		return fail(err)
+	}
+	for _, nodegroup := range nodegroups {
+		versions, err := nodegroupLaunchTemplate(ctx, ec2API, nodegroup)
+		if err != nil {
+			return fail(err)
+		}
+		for _, version := range versions {
+			if version.LaunchTemplateData == nil || version.LaunchTemplateData.MetadataOptions == nil {
+				continue // 메타데이터 옵션을 지정하지 않으면 AMI 기본값을 사용하므로 노드 인스턴스에서 확인
+			}
+			options := version.LaunchTemplateData.MetadataOptions
+			tokens := string(options.HttpTokens)
+			if tokens == "" {
+				tokens = "required" // HttpTokens를 지정하지 않으면 AMI 기본값을 사용하므로 노드 인스턴스에서 확인
+			}
+			prefix := fmt.Sprintf("Nodegroup: %s | LaunchTemplate: %s (버전 %d)", aws.ToString(nodegroup.NodegroupName), aws.ToString(version.LaunchTemplateId), aws.ToInt64(version.VersionNumber))
+			for _, problem := range imdsProblems(string(options.HttpEndpoint), tokens, options.HttpPutResponseHopLimit) {
+				result.Resources = append(result.Resources, prefix+" | "+problem)
+			}
+		}
+	}
+
+	// 3. Karpenter EC2NodeClass (Karpenter가 설치되지 않은 경우 제외)
+	nodeClasses, err := kube.ListAll(ctx, dynamicClient.Resource(ec2NodeClassGVR).List, v1.ListOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fail(err)
+	}
+	if err == nil {
+		for _, nodeClass := range nodeClasses.Items {
+			for _, problem := range ec2NodeClassIMDSProblems(nodeClass) {
+				result.Resources = append(result.Resources, "EC2NodeClass: "+nodeClass.GetName()+" | "+problem)
+			}
+		}
+	}
+
+	// 4. hostNetwork Pod
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
+	if err != nil {
+		return fail(err)
+	}
+	var owners []string
+	hostNetworkNodes := map[string][]string{}
+	for _, pod := range pods.Items {
+		if pod.Namespace == "kube-system" || !pod.Spec.HostNetwork {
+			continue
+		}
+		if enabled, ok := imdsEnabled[pod.Spec.NodeName]; ok && !enabled {
+			continue
+		}
+		owner := "Pod: " + pod.Name
+		if ref := v1.GetControllerOf(&pod); ref != nil {
+			owner = ref.Kind + ": " + ref.Name
+		}
+		owner = "Namespace: " + pod.Namespace + " | " + owner
+		if _, ok := hostNetworkNodes[owner]; !ok {
+			owners = append(owners, owner)
+		}
+		hostNetworkNodes[owner] = append(hostNetworkNodes[owner], pod.Spec.NodeName)
+	}
+	for _, owner := range owners {
+		nodeNames := hostNetworkNodes[owner]
+		location := ""
+		if nodeNames[0] != "" {
+			location = " (Node: " + summarizeNames(nodeNames, 3) + ")"
+		}
+		result.Resources = append(result.Resources, fmt.Sprintf("%s%s | hostNetwork 사용으로 hop limit과 관계없이 IMDS(노드 자격 증명) 접근 가능", owner, location))
+	}
+
+	if len(result.Resources) > 0 {
+		result.Passed = false
+	}
+	return result
+}
+
+// imdsProblems 메타데이터 옵션 문제 (엔드포인트가 비활성화되면 없음, 빈 값은 미지정)
+func imdsProblems(endpoint, tokens string, hopLimit *int32) []string {
+	if endpoint == "disabled" {
+		return nil
+	}
+	var problems []string
+	if tokens != "required" {
+		if tokens == "" {
+			tokens = "미지정"
+		}
+		problems = append(problems, "HttpTokens: "+tokens+" (IMDSv1 허용)")
+	}
+	if hopLimit != nil && *hopLimit > 1 {
+		problems = append(problems, fmt.Sprintf("HttpPutResponseHopLimit: %d (hostNetwork가 아닌 Pod에서 IMDS 접근 가능)", *hopLimit))
+	}
+	return problems
+}
+
+// ec2NodeClassIMDSProblems EC2NodeClass spec.metadataOptions 문제 (지정하지 않은 값은 Karpenter 기본값 required, hop limit 1)
+func ec2NodeClassIMDSProblems(nodeClass unstructured.Unstructured) []string {
+	options, _, _ := unstructured.NestedMap(nodeClass.Object, "spec", "metadataOptions")
+	endpoint, _ := options["httpEndpoint"].(string)
+	tokens, ok := options["httpTokens"].(string)
+	if !ok {
+		tokens = "required"
+	}
+	var hopLimit *int32
+	switch v := options["httpPutResponseHopLimit"].(type) {
+	case int64:
+		hopLimit = aws.Int32(int32(v))
+	case float64:
+		hopLimit = aws.Int32(int32(v))
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			hopLimit = aws.Int32(int32(n))
+		}
+	}
+	return imdsProblems(strings.ToLower(endpoint), strings.ToLower(tokens), hopLimit)
+}
+
+// listNodegroups 클러스터의 관리형 노드 그룹 상세 정보 조회
+func listNodegroups(ctx context.Context, api NodegroupAPI, clusterName string) ([]ekstypes.Nodegroup, error) {
+	var nodegroups []ekstypes.Nodegroup
+	paginator := eks.NewListNodegroupsPaginator(api, &eks.ListNodegroupsInput{ClusterName: aws.String(clusterName)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("노드 그룹 목록 조회 실패: %w", err)
+		}
+		for _, name := range page.Nodegroups {
+			output, err := api.DescribeNodegroup(ctx, &eks.DescribeNodegroupInput{ClusterName: aws.String(clusterName), NodegroupName: aws.String(name)})
+			if err != nil {
+				return nil, fmt.Errorf("노드 그룹 '%s' 상세 정보 조회 실패: %w", name, err)
+			}
+			nodegroups = append(nodegroups, *output.Nodegroup)
+		}
+	}
+	return nodegroups, nil
+}
+
+// nodegroupLaunchTemplate 노드 그룹이 사용하는 시작 템플릿 버전 조회 (시작 템플릿을 사용하지 않으면 nil)
+func nodegroupLaunchTemplate(ctx context.Context, api ec2.DescribeLaunchTemplateVersionsAPIClient, nodegroup ekstypes.Nodegroup) ([]ec2types.LaunchTemplateVersion, error) {
+	lt := nodegroup.LaunchTemplate
+	if lt == nil {
+		return nil, nil
+	}
+	input := &ec2.DescribeLaunchTemplateVersionsInput{}
+	if lt.Id != nil {
+		input.LaunchTemplateId = lt.Id
+	} else {
+		input.LaunchTemplateName = lt.Name
+	}
+	if lt.Version != nil {
+		input.Versions = []string{aws.ToString(lt.Version)}
+	}
+	output, err := api.DescribeLaunchTemplateVersions(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("노드 그룹 %s 시작 템플릿 조회 실패: %w", aws.ToString(nodegroup.NodegroupName), err)
+	}
+	return output.LaunchTemplateVersions, nil
+}
@@ -0,0 +1,152 @@
+package security_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// fakeMetadataOptions 메타데이터 옵션 (빈 값은 미지정)
+type fakeMetadataOptions struct {
+	endpoint string
+	tokens   string
+	hopLimit int32
+}
+
+func toMetadataOptions(v interface{}) map[string]fakeMetadataOptions {
+	options := map[string]fakeMetadataOptions{}
+	raw, _ := v.(map[string]interface{})
+	for id, item := range raw {
+		fields := item.(map[string]interface{})
+		option := fakeMetadataOptions{}
+		option.endpoint, _ = fields["endpoint"].(string)
+		option.tokens, _ = fields["tokens"].(string)
+		if hop, ok := fields["hop_limit"].(int); ok {
+			option.hopLimit = int32(hop)
+		}
+		options[id] = option
+	}
+	return options
+}
+
+// fakeIMDSAPI 인스턴스 ID, 시작 템플릿 ID → 메타데이터 옵션을 돌려주는 EC2 API
+type fakeIMDSAPI struct {
+	instances       map[string]fakeMetadataOptions
+	launchTemplates map[string]fakeMetadataOptions
+}
+
+func (f fakeIMDSAPI) DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	reservation := ec2types.Reservation{}
+	for _, id := range input.InstanceIds {
+		option := f.instances[id]
+		if option.endpoint == "" {
+			option.endpoint = "enabled"
+		}
+		if option.hopLimit == 0 {
+			option.hopLimit = 1
+		}
+		reservation.Instances = append(reservation.Instances, ec2types.Instance{
+			InstanceId: aws.String(id),
+			MetadataOptions: &ec2types.InstanceMetadataOptionsResponse{
+				HttpEndpoint:            ec2types.InstanceMetadataEndpointState(option.endpoint),
+				HttpTokens:              ec2types.HttpTokensState(option.tokens),
+				HttpPutResponseHopLimit: aws.Int32(option.hopLimit),
+			},
+		})
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{reservation}}, nil
+}
+
+func (f fakeIMDSAPI) DescribeLaunchTemplateVersions(ctx context.Context, input *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	id := aws.ToString(input.LaunchTemplateId)
+	data := &ec2types.ResponseLaunchTemplateData{}
+	if option, ok := f.launchTemplates[id]; ok {
+		data.MetadataOptions = &ec2types.LaunchTemplateInstanceMetadataOptions{
+			HttpEndpoint: ec2types.LaunchTemplateInstanceMetadataEndpointState(option.endpoint),
+			HttpTokens:   ec2types.LaunchTemplateHttpTokensState(option.tokens),
+		}
+		if option.hopLimit != 0 {
+			data.MetadataOptions.HttpPutResponseHopLimit = aws.Int32(option.hopLimit)
+		}
+	}
+	return &ec2.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+		{LaunchTemplateId: aws.String(id), VersionNumber: aws.Int64(1), LaunchTemplateData: data},
+	}}, nil
+}
+
+// fakeNodegroupAPI 노드 그룹 이름 → 노드 그룹 상세 정보
+type fakeNodegroupAPI map[string]ekstypes.Nodegroup
+
+// toNodegroups 노드 그룹 이름 → 시작 템플릿 ID(문자열, 빈 값은 시작 템플릿 미사용) 또는
+// launch_template, ssh_key, source_security_groups, remote_access_security_group 필드
+func toNodegroups(v interface{}) fakeNodegroupAPI {
+	nodegroups := fakeNodegroupAPI{}
+	raw, _ := v.(map[string]interface{})
+	for name, item := range raw {
+		nodegroup := ekstypes.Nodegroup{NodegroupName: aws.String(name)}
+		fields, ok := item.(map[string]interface{})
+		if !ok {
+			fields = map[string]interface{}{"launch_template": item}
+		}
+		if id, _ := fields["launch_template"].(string); id != "" {
+			nodegroup.LaunchTemplate = &ekstypes.LaunchTemplateSpecification{Id: aws.String(id), Version: aws.String("1")}
+		}
+		if key, _ := fields["ssh_key"].(string); key != "" {
+			nodegroup.RemoteAccess = &ekstypes.RemoteAccessConfig{Ec2SshKey: aws.String(key), SourceSecurityGroups: testutils.ToStrings(fields["source_security_groups"])}
+		}
+		if group, _ := fields["remote_access_security_group"].(string); group != "" {
+			nodegroup.Resources = &ekstypes.NodegroupResources{RemoteAccessSecurityGroup: aws.String(group)}
+		}
+		nodegroups[name] = nodegroup
+	}
+	return nodegroups
+}
+
+func (f fakeNodegroupAPI) ListNodegroups(ctx context.Context, input *eks.ListNodegroupsInput, optFns ...func(*eks.Options)) (*eks.ListNodegroupsOutput, error) {
+	var names []string
+	for name := range f {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return &eks.ListNodegroupsOutput{Nodegroups: names}, nil
+}
+
+func (f fakeNodegroupAPI) DescribeNodegroup(ctx context.Context, input *eks.DescribeNodegroupInput, optFns ...func(*eks.Options)) (*eks.DescribeNodegroupOutput, error) {
+	nodegroup := f[aws.ToString(input.NodegroupName)]
+	return &eks.DescribeNodegroupOutput{Nodegroup: &nodegroup}, nil
+}
+
+func TestEvaluateIMDSHardening_YAML(t *testing.T) {
+	// YAML 파일 "imds.yaml"에서 테스트 케이스 로드
+	testCases := testutils.LoadTestCases(t, "imds.yaml")
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		expectPass := tc["expected"].(bool)
+
+		t.Run(testName, func(t *testing.T) {
+			set := testutils.LoadManifests(t, tc["objects"])
+			ec2API := fakeIMDSAPI{
+				instances:       toMetadataOptions(tc["instances"]),
+				launchTemplates: toMetadataOptions(tc["launch_templates"]),
+			}
+			eksAPI := toNodegroups(tc["nodegroups"])
+			cluster := security.EksCluster{Cluster: &ekstypes.Cluster{Name: aws.String("test-cluster")}}
+			if noCluster, _ := tc["no_cluster"].(bool); noCluster {
+				cluster.Cluster = nil
+			}
+
+			// 함수 실행 및 반환값 비교
+			result := security.EvaluateIMDSHardening(context.Background(), set.Clientset(), set.DynamicClient(), ec2API, eksAPI, cluster)
+			testutils.AssertCheckResult(t, tc, expectPass, result)
+		})
+	}
+}
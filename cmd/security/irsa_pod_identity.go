@@ -2,15 +2,86 @@ package security
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
-func CheckIRSAAndPodIdentity(clientset kubernetes.Interface) common.CheckResult {
+const (
+	// irsaRoleAnnotation IRSA 역할을 지정하는 ServiceAccount 어노테이션
+	irsaRoleAnnotation = "eks.amazonaws.com/role-arn"
+	// irsaAudienceAnnotation IRSA 토큰 audience를 바꾸는 ServiceAccount 어노테이션 (기본값 sts.amazonaws.com)
+	irsaAudienceAnnotation = "eks.amazonaws.com/audience"
+	irsaDefaultAudience    = "sts.amazonaws.com"
+	// podIdentityAgent EKS Pod Identity Agent 애드온/DaemonSet 이름 (kube-system)
+	podIdentityAgent = "eks-pod-identity-agent"
+	// podIdentityPrincipal Pod Identity 역할 신뢰 정책에 필요한 서비스 principal
+	podIdentityPrincipal = "pods.eks.amazonaws.com"
+)
+
+// nodeCredentialImages AWS API를 호출하는 것으로 알려진 컴포넌트 이미지 (IRSA/Pod Identity가 없으면 노드 자격 증명 사용)
+var nodeCredentialImages = []string{
+	"amazon-k8s-cni",
+	"aws-load-balancer-controller",
+	"aws-ebs-csi-driver",
+	"aws-efs-csi-driver",
+	"aws-for-fluent-bit",
+	"aws-node-termination-handler",
+	"aws-otel-collector",
+	"cloudwatch-agent",
+	"cluster-autoscaler",
+	"external-dns",
+	"external-secrets",
+	"karpenter",
+	"secrets-store-csi-driver-provider-aws",
+}
+
+// nodeCredentialEnvs AWS SDK 사용을 나타내는 환경 변수
+var nodeCredentialEnvs = []string{"AWS_REGION", "AWS_DEFAULT_REGION"}
+
+// IRSAAPI SEC-003 점검에 사용하는 IAM API
+type IRSAAPI interface {
+	IAMRoleAPI
+	ListOpenIDConnectProviders(ctx context.Context, params *iam.ListOpenIDConnectProvidersInput, optFns ...func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error)
+}
+
+// PodIdentityAPI SEC-003 점검에 사용하는 EKS API
+type PodIdentityAPI interface {
+	eks.ListPodIdentityAssociationsAPIClient
+	DescribePodIdentityAssociation(ctx context.Context, params *eks.DescribePodIdentityAssociationInput, optFns ...func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error)
+	DescribeAddon(ctx context.Context, params *eks.DescribeAddonInput, optFns ...func(*eks.Options)) (*eks.DescribeAddonOutput, error)
+}
+
+func CheckIRSAAndPodIdentity(ctx context.Context, clientset kubernetes.Interface, cfg aws.Config, eksCluster EksCluster) common.CheckResult {
+	return EvaluateIRSAAndPodIdentity(ctx, clientset, iam.NewFromConfig(cfg), eks.NewFromConfig(cfg), eksCluster)
+}
+
+// EvaluateIRSAAndPodIdentity IRSA 어노테이션이 있는 ServiceAccount와 EKS Pod Identity 연결의 IAM 역할 검증
+// IRSA
+// - 클러스터 OIDC 발급자에 대응하는 IAM OIDC 공급자가 있는지
+// - 역할이 존재하는지
+// - 신뢰 정책의 sub/aud 조건이 이 네임스페이스/ServiceAccount와 정확히 일치하고 와일드카드가 아닌지
+// Pod Identity
+// - eks-pod-identity-agent 애드온/DaemonSet이 정상인지
+// - 연결의 네임스페이스/ServiceAccount와 역할이 존재하는지
+// - 역할 신뢰 정책이 pods.eks.amazonaws.com을 허용하는지
+// 공통으로 역할 정책에 모든 리소스에 대한 모든 동작(*:*) 허용이 없는지 확인하고, IRSA와 Pod Identity가 함께 설정된 ServiceAccount를 표시
+// IRSA/Pod Identity 없이 AWS API를 호출하는 것으로 보이는 Pod의 ServiceAccount(노드 자격 증명 사용)는 별도로 표시
+func EvaluateIRSAAndPodIdentity(ctx context.Context, clientset kubernetes.Interface, api IRSAAPI, eksAPI PodIdentityAPI, eksCluster EksCluster) common.CheckResult {
 	result := common.CheckResult{
 		CheckName: "[SEC-003] IRSA 또는 EKS Pod Identity 기반 권한 부여",
 		Manual:    false,
@@ -19,29 +90,442 @@ func CheckIRSAAndPodIdentity(clientset kubernetes.Interface) common.CheckResult
 		FailureMsg: "일부 서비스 계정이 IRSA 또는 EKS Pod Identity를 사용하지 않고 있습니다.",
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-003",
 	}
+	if eksCluster.Cluster == nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + errNoCluster.Error()
+		return result
+	}
 
-	saList, err := clientset.CoreV1().ServiceAccounts("").List(context.TODO(), v1.ListOptions{
-		FieldSelector: "metadata.namespace!=kube-system", // kube-system 네임스페이스 제외
-	})
+	saList, err := kube.ListAll(ctx, clientset.CoreV1().ServiceAccounts("").List, v1.ListOptions{})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	pods, err := kube.ListAll(ctx, clientset.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
 		return result
 	}
 
-	// IRSA 또는 Pod Identity를 사용하지 않는 Service Account 수집
-	for _, sa := range saList.Items {
-		annotations := sa.Annotations
+	// 역할 조회 결과는 여러 ServiceAccount와 Pod Identity 연결이 공유
+	roles := roleCache{}
 
-		_, hasIRSA := annotations["eks.amazonaws.com/role-arn"]
-		_, hasIdentity := annotations["eks.amazonaws.com/identity"]
-		_, hasAudience := annotations["eks.amazonaws.com/audience"]
+	// 1. IRSA 역할 검증
+	roleFindings, err := irsaRoleFindings(ctx, api, roles, eksCluster, saList.Items)
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
 
-		if !(hasIRSA || hasIdentity || hasAudience) {
-			result.Passed = false
-			result.Resources = append(result.Resources, fmt.Sprintf("ServiceAccount: %s/%s", sa.Namespace, sa.Name))
-		}
+	// 2. Pod Identity 연결 검증
+	associations, associationFindings, err := podIdentityFindings(ctx, clientset, api, eksAPI, roles, eksCluster, saList.Items)
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
 	}
+	roleFindings = append(roleFindings, associationFindings...)
 
+	// 3. 노드 자격 증명을 사용하는 것으로 보이는 ServiceAccount
+	nodeFindings := nodeCredentialFindings(saList.Items, associations, pods.Items)
+
+	if len(roleFindings) > 0 || len(nodeFindings) > 0 {
+		result.Passed = false
+		result.FailureMsg = fmt.Sprintf("IRSA/Pod Identity 역할 설정 문제 %d건, 노드 자격 증명을 사용하는 것으로 보이는 ServiceAccount %d개가 발견되었습니다.", len(roleFindings), len(nodeFindings))
+		result.Resources = append(roleFindings, nodeFindings...)
+	}
 	return result
 }
+
+// roleInfo 역할 조회 결과 (역할 이름 → 신뢰 정책, 존재 여부, 권한 문제)
+type roleInfo struct {
+	trust    *PolicyDocument
+	missing  bool
+	problems []string
+}
+
+type roleCache map[string]*roleInfo
+
+// load 역할과 신뢰 정책, 정책 문서의 *:* 허용 조회 (한 번만 조회)
+func (c roleCache) load(ctx context.Context, api IAMRoleAPI, roleName string) (*roleInfo, error) {
+	if info, ok := c[roleName]; ok {
+		return info, nil
+	}
+	info := &roleInfo{}
+	role, err := getRole(ctx, api, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		info.missing = true
+		c[roleName] = info
+		return info, nil
+	}
+	info.trust, err = ParsePolicyDocument(aws.ToString(role.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, fmt.Errorf("역할 %s 신뢰 정책: %w", roleName, err)
+	}
+	policies, err := listRolePolicies(ctx, api, roleName)
+	if err != nil {
+		return nil, err
+	}
+	for _, policy := range policies {
+		for i, statement := range policy.Document.Statement {
+			if allowsFullAccess(statement) {
+				info.problems = append(info.problems, "정책 "+policy.Name+" 문장 "+statementLabel(statement, i)+": 모든 리소스에 모든 동작(*:*) 허용")
+			}
+		}
+	}
+	c[roleName] = info
+	return info, nil
+}
+
+// irsaRoleFindings IRSA 어노테이션이 있는 ServiceAccount의 OIDC 공급자, 역할, 신뢰 정책, 권한 문제
+func irsaRoleFindings(ctx context.Context, api IRSAAPI, roles roleCache, eksCluster EksCluster, serviceAccounts []corev1.ServiceAccount) ([]string, error) {
+	var irsaAccounts []corev1.ServiceAccount
+	for _, sa := range serviceAccounts {
+		if sa.Annotations[irsaRoleAnnotation] != "" {
+			irsaAccounts = append(irsaAccounts, sa)
+		}
+	}
+	if len(irsaAccounts) == 0 {
+		return nil, nil
+	}
+
+	// 클러스터 OIDC 발급자와 IAM OIDC 공급자
+	var issuer string
+	if eksCluster.Cluster.Identity != nil && eksCluster.Cluster.Identity.Oidc != nil {
+		issuer = strings.TrimPrefix(aws.ToString(eksCluster.Cluster.Identity.Oidc.Issuer), "https://")
+	}
+	if issuer == "" {
+		return []string{"클러스터에 OIDC 발급자가 없어 IRSA를 사용할 수 없음"}, nil
+	}
+
+	var findings []string
+	providers, err := api.ListOpenIDConnectProviders(ctx, &iam.ListOpenIDConnectProvidersInput{})
+	if err != nil {
+		return nil, fmt.Errorf("IAM OIDC 공급자 조회 실패: %w", err)
+	}
+	providerArn := ""
+	for _, provider := range providers.OpenIDConnectProviderList {
+		if strings.HasSuffix(aws.ToString(provider.Arn), ":oidc-provider/"+issuer) {
+			providerArn = aws.ToString(provider.Arn)
+		}
+	}
+	if providerArn == "" {
+		findings = append(findings, "OIDC 발급자: "+issuer+" | 대응하는 IAM OIDC 공급자 없음 (IRSA 역할을 맡을 수 없음)")
+	}
+
+	for _, sa := range irsaAccounts {
+		roleArn := sa.Annotations[irsaRoleAnnotation]
+		roleName := roleNameFromArn(roleArn)
+		prefix := fmt.Sprintf("ServiceAccount: %s/%s | Role: %s", sa.Namespace, sa.Name, roleName)
+
+		info, err := roles.load(ctx, api, roleName)
+		if err != nil {
+			return nil, err
+		}
+
+		if info.missing {
+			findings = append(findings, prefix+" | 역할이 존재하지 않음 ("+roleArn+")")
+			continue
+		}
+		audience := sa.Annotations[irsaAudienceAnnotation]
+		if audience == "" {
+			audience = irsaDefaultAudience
+		}
+		for _, problem := range trustPolicyProblems(info.trust, issuer, sa.Namespace, sa.Name, audience) {
+			findings = append(findings, prefix+" | 신뢰 정책: "+problem)
+		}
+		for _, problem := range info.problems {
+			findings = append(findings, prefix+" | "+problem)
+		}
+	}
+	return findings, nil
+}
+
+// trustPolicyProblems 클러스터 OIDC 공급자에 대한 sts:AssumeRoleWithWebIdentity 허용 문장의 sub/aud 조건 문제
+// 여러 ServiceAccount가 역할을 공유할 수 있으므로 sub 불일치는 어느 문장도 이 ServiceAccount와 일치하지 않을 때만 표시
+func trustPolicyProblems(trust *PolicyDocument, issuer, namespace, name, audience string) []string {
+	expectedSub := "system:serviceaccount:" + namespace + ":" + name
+	subKey := strings.ToLower(issuer + ":sub")
+	audKey := strings.ToLower(issuer + ":aud")
+
+	var problems []string
+	trusted := false
+	subMatched := false
+	var subValues []string
+	for i, statement := range trust.Statement {
+		if statement.Effect != "Allow" || !slices.ContainsFunc(statement.Principal["Federated"], func(arn string) bool {
+			return strings.HasSuffix(arn, ":oidc-provider/"+issuer)
+		}) {
+			continue
+		}
+		if !slices.ContainsFunc(statement.Action, func(action string) bool {
+			return action == "sts:AssumeRoleWithWebIdentity" || action == "sts:*" || action == "*"
+		}) {
+			continue
+		}
+		trusted = true
+		label := statementLabel(statement, i)
+
+		subs := conditionValues(statement.Condition, subKey)
+		auds := conditionValues(statement.Condition, audKey)
+		switch {
+		case len(subs) == 0:
+			problems = append(problems, "문장 "+label+": sub 조건 없음 (이 OIDC 공급자의 모든 ServiceAccount가 역할을 맡을 수 있음)")
+		case slices.ContainsFunc(subs, isWildcardValue):
+			problems = append(problems, "문장 "+label+": sub 조건 와일드카드 ("+strings.Join(subs, ", ")+")")
+		case slices.Contains(subs, expectedSub):
+			subMatched = true
+		default:
+			subValues = append(subValues, subs...)
+		}
+		switch {
+		case len(auds) == 0:
+			problems = append(problems, "문장 "+label+": aud 조건 없음")
+		case slices.ContainsFunc(auds, isWildcardValue):
+			problems = append(problems, "문장 "+label+": aud 조건 와일드카드 ("+strings.Join(auds, ", ")+")")
+		case !slices.Contains(auds, audience):
+			problems = append(problems, "문장 "+label+": aud 조건이 "+audience+"와 다름 ("+strings.Join(auds, ", ")+")")
+		}
+	}
+
+	if !trusted {
+		return []string{"클러스터 OIDC 공급자(" + issuer + ")의 sts:AssumeRoleWithWebIdentity 허용 없음"}
+	}
+	if !subMatched && len(subValues) > 0 {
+		problems = append(problems, "sub 조건이 "+expectedSub+"와 일치하지 않음 ("+strings.Join(subValues, ", ")+")")
+	}
+	return problems
+}
+
+// podIdentityFindings 클러스터의 Pod Identity 연결과 에이전트 문제
+// 연결된 ServiceAccount("네임스페이스/이름" → 역할 ARN)도 함께 반환
+func podIdentityFindings(ctx context.Context, clientset kubernetes.Interface, api IAMRoleAPI, eksAPI PodIdentityAPI, roles roleCache, eksCluster EksCluster, serviceAccounts []corev1.ServiceAccount) (map[string]string, []string, error) {
+	clusterName := aws.ToString(eksCluster.Cluster.Name)
+
+	var associations []ekstypes.PodIdentityAssociation
+	paginator := eks.NewListPodIdentityAssociationsPaginator(eksAPI, &eks.ListPodIdentityAssociationsInput{ClusterName: aws.String(clusterName)})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Pod Identity 연결 조회 실패: %w", err)
+		}
+		for _, summary := range page.Associations {
+			output, err := eksAPI.DescribePodIdentityAssociation(ctx, &eks.DescribePodIdentityAssociationInput{
+				ClusterName:   aws.String(clusterName),
+				AssociationId: summary.AssociationId,
+			})
+			if err != nil {
+				return nil, nil, fmt.Errorf("Pod Identity 연결 %s 조회 실패: %w", aws.ToString(summary.AssociationId), err)
+			}
+			associations = append(associations, *output.Association)
+		}
+	}
+	if len(associations) == 0 {
+		return nil, nil, nil
+	}
+
+	// 1. Pod Identity Agent
+	findings, err := podIdentityAgentFindings(ctx, clientset, eksAPI, clusterName)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 2. 연결별 네임스페이스, ServiceAccount, 역할
+	namespaces, err := kube.ListAll(ctx, clientset.CoreV1().Namespaces().List, v1.ListOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	existingNamespaces := map[string]bool{}
+	for _, ns := range namespaces.Items {
+		existingNamespaces[ns.Name] = true
+	}
+	serviceAccountsByKey := map[string]corev1.ServiceAccount{}
+	for _, sa := range serviceAccounts {
+		serviceAccountsByKey[sa.Namespace+"/"+sa.Name] = sa
+	}
+
+	associated := map[string]string{}
+	for _, association := range associations {
+		key := aws.ToString(association.Namespace) + "/" + aws.ToString(association.ServiceAccount)
+		roleArn := aws.ToString(association.RoleArn)
+		roleName := roleNameFromArn(roleArn)
+		prefix := "Pod Identity 연결: " + key + " | Role: " + roleName
+		associated[key] = roleArn
+
+		sa, saExists := serviceAccountsByKey[key]
+		switch {
+		case !existingNamespaces[aws.ToString(association.Namespace)]:
+			findings = append(findings, prefix+" | 네임스페이스가 존재하지 않음")
+		case !saExists:
+			findings = append(findings, prefix+" | ServiceAccount가 존재하지 않음")
+		case sa.Annotations[irsaRoleAnnotation] != "":
+			findings = append(findings, fmt.Sprintf("%s | IRSA(%s)와 Pod Identity가 함께 설정됨 (대부분의 AWS SDK는 IRSA 자격 증명을 먼저 사용)", prefix, roleNameFromArn(sa.Annotations[irsaRoleAnnotation])))
+		}
+
+		info, err := roles.load(ctx, api, roleName)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info.missing {
+			findings = append(findings, prefix+" | 역할이 존재하지 않음 ("+roleArn+")")
+			continue
+		}
+		if !trustsPodIdentity(info.trust) {
+			findings = append(findings, prefix+" | 신뢰 정책: "+podIdentityPrincipal+"의 sts:AssumeRole, sts:TagSession 허용 없음")
+		}
+		for _, problem := range info.problems {
+			findings = append(findings, prefix+" | "+problem)
+		}
+	}
+	return associated, findings, nil
+}
+
+// podIdentityAgentFindings eks-pod-identity-agent 애드온 상태와 DaemonSet 준비 상태
+// 애드온 없이 직접 설치한 DaemonSet도 허용
+func podIdentityAgentFindings(ctx context.Context, clientset kubernetes.Interface, eksAPI PodIdentityAPI, clusterName string) ([]string, error) {
+	var findings []string
+	addonInstalled := true
+	output, err := eksAPI.DescribeAddon(ctx, &eks.DescribeAddonInput{ClusterName: aws.String(clusterName), AddonName: aws.String(podIdentityAgent)})
+	if err != nil {
+		var notFound *ekstypes.ResourceNotFoundException
+		if !errors.As(err, &notFound) {
+			return nil, fmt.Errorf("%s 애드온 조회 실패: %w", podIdentityAgent, err)
+		}
+		addonInstalled = false
+	} else {
+		if output.Addon.Status != ekstypes.AddonStatusActive {
+			findings = append(findings, fmt.Sprintf("Pod Identity Agent: %s 애드온 상태 %s", podIdentityAgent, output.Addon.Status))
+		}
+		if output.Addon.Health != nil {
+			for _, issue := range output.Addon.Health.Issues {
+				findings = append(findings, fmt.Sprintf("Pod Identity Agent: %s 애드온 문제 %s (%s)", podIdentityAgent, issue.Code, aws.ToString(issue.Message)))
+			}
+		}
+	}
+
+	daemonSet, err := clientset.AppsV1().DaemonSets("kube-system").Get(ctx, podIdentityAgent, v1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if !addonInstalled {
+			return append(findings, "Pod Identity Agent: "+podIdentityAgent+" 애드온/DaemonSet 없음 (Pod Identity 연결이 동작하지 않음)"), nil
+		}
+		return append(findings, "Pod Identity Agent: kube-system/"+podIdentityAgent+" DaemonSet 없음"), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s DaemonSet 조회 실패: %w", podIdentityAgent, err)
+	}
+	status := daemonSet.Status
+	if status.DesiredNumberScheduled == 0 || status.NumberReady < status.DesiredNumberScheduled {
+		findings = append(findings, fmt.Sprintf("Pod Identity Agent: kube-system/%s DaemonSet 준비 %d/%d", podIdentityAgent, status.NumberReady, status.DesiredNumberScheduled))
+	}
+	return findings, nil
+}
+
+// trustsPodIdentity 신뢰 정책이 pods.eks.amazonaws.com에 sts:AssumeRole과 sts:TagSession을 허용하는지
+func trustsPodIdentity(trust *PolicyDocument) bool {
+	var actions []string
+	for _, statement := range trust.Statement {
+		if statement.Effect == "Allow" && slices.Contains(statement.Principal["Service"], podIdentityPrincipal) {
+			actions = append(actions, statement.Action...)
+		}
+	}
+	allows := func(action string) bool {
+		return slices.Contains(actions, action) || slices.Contains(actions, "sts:*") || slices.Contains(actions, "*")
+	}
+	return allows("sts:AssumeRole") && allows("sts:TagSession")
+}
+
+// conditionValues 모든 조건 연산자에서 조건 키(대소문자 무시)의 값 수집
+func conditionValues(condition map[string]map[string]StringList, key string) []string {
+	var values []string
+	for _, keys := range condition {
+		for k, v := range keys {
+			if strings.ToLower(k) == key {
+				values = append(values, v...)
+			}
+		}
+	}
+	return values
+}
+
+func isWildcardValue(value string) bool {
+	return strings.ContainsAny(value, "*?")
+}
+
+// nodeCredentialFindings IRSA/Pod Identity 없이 AWS API를 호출하는 것으로 보이는 Pod의 ServiceAccount
+// AWS 컴포넌트 이미지 또는 AWS SDK 리전 환경 변수를 근거로 판단 (Fargate Pod는 노드 자격 증명에 접근할 수 없으므로 제외)
+func nodeCredentialFindings(serviceAccounts []corev1.ServiceAccount, associations map[string]string, pods []corev1.Pod) []string {
+	configured := map[string]bool{}
+	for _, sa := range serviceAccounts {
+		if sa.Annotations[irsaRoleAnnotation] != "" {
+			configured[sa.Namespace+"/"+sa.Name] = true
+		}
+	}
+	for key := range associations {
+		configured[key] = true
+	}
+
+	reasons := map[string]string{}
+	for _, pod := range pods {
+		if strings.HasPrefix(pod.Spec.NodeName, "fargate-") {
+			continue
+		}
+		saName := pod.Spec.ServiceAccountName
+		if saName == "" {
+			saName = "default"
+		}
+		key := pod.Namespace + "/" + saName
+		if configured[key] || reasons[key] != "" {
+			continue
+		}
+		if reason := awsAccessReason(pod.Spec); reason != "" {
+			reasons[key] = "Pod: " + pod.Name + " | 근거: " + reason
+		}
+	}
+
+	var findings []string
+	for key, reason := range reasons {
+		findings = append(findings, "노드 자격 증명 사용: ServiceAccount: "+key+" | "+reason)
+	}
+	sort.Strings(findings)
+	return findings
+}
+
+// awsAccessReason Pod가 AWS API를 호출하는 것으로 보이는 근거 (없으면 빈 문자열)
+// 정적 액세스 키(AWS_ACCESS_KEY_ID)나 IRSA 토큰 환경 변수를 직접 지정한 Pod는 노드 자격 증명을 쓰지 않으므로 제외
+func awsAccessReason(spec corev1.PodSpec) string {
+	containers := append(slices.Clone(spec.InitContainers), spec.Containers...)
+	for _, c := range containers {
+		for _, env := range c.Env {
+			if env.Name == "AWS_ACCESS_KEY_ID" || env.Name == "AWS_WEB_IDENTITY_TOKEN_FILE" || env.Name == "AWS_CONTAINER_CREDENTIALS_FULL_URI" {
+				return ""
+			}
+		}
+	}
+	for _, c := range containers {
+		// 이미지 저장소 경로의 각 구성 요소로 비교 (예: public.ecr.aws/karpenter/controller)
+		repository := c.Image
+		if i := strings.Index(repository, "@"); i >= 0 {
+			repository = repository[:i]
+		}
+		if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+			repository = repository[:i]
+		}
+		for _, segment := range strings.Split(repository, "/") {
+			if slices.Contains(nodeCredentialImages, segment) {
+				return "이미지 " + c.Image
+			}
+		}
+		for _, env := range c.Env {
+			if slices.Contains(nodeCredentialEnvs, env.Name) {
+				return "컨테이너 " + c.Name + " 환경 변수 " + env.Name
+			}
+		}
+	}
+	return ""
+}
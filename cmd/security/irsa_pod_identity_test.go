@@ -2,74 +2,192 @@ package security_test
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 
 	"eks-checklist/cmd/security"
 	"eks-checklist/cmd/testutils"
 
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes/fake"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
+const testIssuer = "oidc.eks.ap-northeast-2.amazonaws.com/id/EXAMPLE"
+
+// fakeIAMRole 신뢰 정책과 관리형/인라인 정책 문서 (정책 이름 → 문서)
+type fakeIAMRole struct {
+	trust    string
+	attached map[string]string
+	inline   map[string]string
+}
+
+// fakeIRSAAPI IAM OIDC 공급자와 역할, 정책 문서를 돌려주는 IAM API
+type fakeIRSAAPI struct {
+	providers []string
+	roles     map[string]fakeIAMRole
+}
+
+func (f fakeIRSAAPI) ListOpenIDConnectProviders(ctx context.Context, input *iam.ListOpenIDConnectProvidersInput, optFns ...func(*iam.Options)) (*iam.ListOpenIDConnectProvidersOutput, error) {
+	output := &iam.ListOpenIDConnectProvidersOutput{}
+	for _, arn := range f.providers {
+		output.OpenIDConnectProviderList = append(output.OpenIDConnectProviderList, iamtypes.OpenIDConnectProviderListEntry{Arn: aws.String(arn)})
+	}
+	return output, nil
+}
+
+func (f fakeIRSAAPI) GetRole(ctx context.Context, input *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	role, ok := f.roles[aws.ToString(input.RoleName)]
+	if !ok {
+		return nil, &iamtypes.NoSuchEntityException{Message: aws.String("role not found")}
+	}
+	// IAM API와 같이 URL 인코딩된 신뢰 정책
+	return &iam.GetRoleOutput{Role: &iamtypes.Role{RoleName: input.RoleName, AssumeRolePolicyDocument: aws.String(url.PathEscape(role.trust))}}, nil
+}
+
+func (f fakeIRSAAPI) ListAttachedRolePolicies(ctx context.Context, input *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+	output := &iam.ListAttachedRolePoliciesOutput{}
+	// 키가 ARN이면 그대로 사용 (AWS 관리형 정책), 이름이면 고객 관리형 정책
+	for key := range f.roles[aws.ToString(input.RoleName)].attached {
+		arn := key
+		if !strings.HasPrefix(key, "arn:") {
+			arn = "arn:aws:iam::123456789012:policy/" + key
+		}
+		output.AttachedPolicies = append(output.AttachedPolicies, iamtypes.AttachedPolicy{PolicyName: aws.String(arn[strings.LastIndex(arn, "/")+1:]), PolicyArn: aws.String(arn)})
+	}
+	return output, nil
+}
+
+func (f fakeIRSAAPI) GetPolicy(ctx context.Context, input *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error) {
+	return &iam.GetPolicyOutput{Policy: &iamtypes.Policy{Arn: input.PolicyArn, DefaultVersionId: aws.String("v1")}}, nil
+}
+
+func (f fakeIRSAAPI) GetPolicyVersion(ctx context.Context, input *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error) {
+	arn := aws.ToString(input.PolicyArn)
+	for _, role := range f.roles {
+		for _, key := range []string{arn, arn[strings.LastIndex(arn, "/")+1:]} {
+			if document, ok := role.attached[key]; ok {
+				return &iam.GetPolicyVersionOutput{PolicyVersion: &iamtypes.PolicyVersion{Document: aws.String(url.PathEscape(document))}}, nil
+			}
+		}
+	}
+	return nil, &iamtypes.NoSuchEntityException{Message: aws.String("policy not found")}
+}
+
+func (f fakeIRSAAPI) ListRolePolicies(ctx context.Context, input *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	output := &iam.ListRolePoliciesOutput{}
+	for name := range f.roles[aws.ToString(input.RoleName)].inline {
+		output.PolicyNames = append(output.PolicyNames, name)
+	}
+	return output, nil
+}
+
+func (f fakeIRSAAPI) GetRolePolicy(ctx context.Context, input *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error) {
+	document := f.roles[aws.ToString(input.RoleName)].inline[aws.ToString(input.PolicyName)]
+	return &iam.GetRolePolicyOutput{PolicyDocument: aws.String(url.PathEscape(document))}, nil
+}
+
+// fakePodIdentityAPI Pod Identity 연결과 eks-pod-identity-agent 애드온을 돌려주는 EKS API (addon이 nil이면 애드온 없음)
+type fakePodIdentityAPI struct {
+	associations []types.PodIdentityAssociation
+	addon        *types.Addon
+}
+
+func (f fakePodIdentityAPI) ListPodIdentityAssociations(ctx context.Context, input *eks.ListPodIdentityAssociationsInput, optFns ...func(*eks.Options)) (*eks.ListPodIdentityAssociationsOutput, error) {
+	output := &eks.ListPodIdentityAssociationsOutput{}
+	for _, association := range f.associations {
+		output.Associations = append(output.Associations, types.PodIdentityAssociationSummary{
+			AssociationId:  association.AssociationId,
+			Namespace:      association.Namespace,
+			ServiceAccount: association.ServiceAccount,
+		})
+	}
+	return output, nil
+}
+
+func (f fakePodIdentityAPI) DescribePodIdentityAssociation(ctx context.Context, input *eks.DescribePodIdentityAssociationInput, optFns ...func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error) {
+	for _, association := range f.associations {
+		if aws.ToString(association.AssociationId) == aws.ToString(input.AssociationId) {
+			return &eks.DescribePodIdentityAssociationOutput{Association: &association}, nil
+		}
+	}
+	return nil, &types.ResourceNotFoundException{Message: aws.String("association not found")}
+}
+
+func (f fakePodIdentityAPI) DescribeAddon(ctx context.Context, input *eks.DescribeAddonInput, optFns ...func(*eks.Options)) (*eks.DescribeAddonOutput, error) {
+	if f.addon == nil {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("addon not found")}
+	}
+	return &eks.DescribeAddonOutput{Addon: f.addon}, nil
+}
+
+func toStringMap(v interface{}) map[string]string {
+	result := map[string]string{}
+	if v == nil {
+		return result
+	}
+	for key, value := range v.(map[string]interface{}) {
+		result[key] = value.(string)
+	}
+	return result
+}
+
 func TestCheckIRSAAndPodIdentity_YAML(t *testing.T) {
 	// YAML 파일 "irsa_pod_identity.yaml"에서 테스트 케이스 로드
 	testCases := testutils.LoadTestCases(t, "irsa_pod_identity.yaml")
 	for _, tc := range testCases {
 		testName := tc["name"].(string)
-		// YAML의 "expect_pass" 필드를 그대로 사용
 		expectedPass := tc["expect_pass"].(bool)
 
-		serviceAccountsRaw, ok := tc["serviceaccounts"].([]interface{})
-		if !ok {
-			t.Fatalf("Test case '%s' is missing 'serviceaccounts' field", testName)
-		}
-
 		t.Run(testName, func(t *testing.T) {
-			client := fake.NewSimpleClientset()
+			set := testutils.LoadManifests(t, tc["objects"])
 
-			// YAML에 정의된 각 ServiceAccount 객체 생성 (kube-system 네임스페이스는 테스트 대상에서 제외됨)
-			for _, saRaw := range serviceAccountsRaw {
-				saMap, ok := saRaw.(map[string]interface{})
-				if !ok {
-					t.Fatalf("Test case '%s': serviceaccount is not a map", testName)
-				}
-				ns, ok := saMap["namespace"].(string)
-				if !ok {
-					t.Fatalf("Test case '%s': serviceaccount missing 'namespace'", testName)
-				}
-				name, ok := saMap["name"].(string)
-				if !ok {
-					t.Fatalf("Test case '%s': serviceaccount missing 'name'", testName)
-				}
-				annotations := make(map[string]string)
-				if annRaw, exists := saMap["annotations"].(map[string]interface{}); exists {
-					for key, val := range annRaw {
-						sVal, ok := val.(string)
-						if !ok {
-							t.Fatalf("Test case '%s': annotation value for key %s is not a string", testName, key)
-						}
-						annotations[key] = sVal
+			api := fakeIRSAAPI{providers: testutils.ToStrings(tc["oidc_providers"]), roles: map[string]fakeIAMRole{}}
+			if roles, ok := tc["roles"].(map[string]interface{}); ok {
+				for name, raw := range roles {
+					role := raw.(map[string]interface{})
+					api.roles[name] = fakeIAMRole{
+						trust:    role["trust"].(string),
+						attached: toStringMap(role["attached"]),
+						inline:   toStringMap(role["inline"]),
 					}
 				}
-				sa := &corev1.ServiceAccount{
-					ObjectMeta: metav1.ObjectMeta{
-						Name:        name,
-						Namespace:   ns,
-						Annotations: annotations,
-					},
-				}
-				_, err := client.CoreV1().ServiceAccounts(ns).Create(context.TODO(), sa, metav1.CreateOptions{})
-				if err != nil {
-					t.Fatalf("Test case '%s': failed to create serviceaccount %s/%s: %v", testName, ns, name, err)
+			}
+
+			eksAPI := fakePodIdentityAPI{}
+			for i, raw := range toMaps(tc["pod_identity_associations"]) {
+				eksAPI.associations = append(eksAPI.associations, types.PodIdentityAssociation{
+					AssociationId:  aws.String(fmt.Sprintf("a-%d", i)),
+					Namespace:      aws.String(raw["namespace"].(string)),
+					ServiceAccount: aws.String(raw["service_account"].(string)),
+					RoleArn:        aws.String(raw["role_arn"].(string)),
+				})
+			}
+			if addon, ok := tc["pod_identity_addon"].(map[string]interface{}); ok {
+				eksAPI.addon = &types.Addon{Status: types.AddonStatus(addon["status"].(string)), Health: &types.AddonHealth{}}
+				for _, issue := range toMaps(addon["issues"]) {
+					eksAPI.addon.Health.Issues = append(eksAPI.addon.Health.Issues, types.AddonIssue{
+						Code:    types.AddonIssueCode(issue["code"].(string)),
+						Message: aws.String(issue["message"].(string)),
+					})
 				}
 			}
 
-			// CheckIRSAAndPodIdentity 함수 실행 및 반환값 검증
-			result := security.CheckIRSAAndPodIdentity(client)
-			if result.Passed != expectedPass {
-				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectedPass, result)
+			cluster := &types.Cluster{
+				Name:     aws.String("test-cluster"),
+				Identity: &types.Identity{Oidc: &types.OIDC{Issuer: aws.String("https://" + testIssuer)}},
 			}
+			if noCluster, _ := tc["no_cluster"].(bool); noCluster {
+				cluster = nil
+			}
+
+			result := security.EvaluateIRSAAndPodIdentity(context.Background(), set.Clientset(), api, eksAPI, security.EksCluster{Cluster: cluster})
+
+			testutils.AssertCheckResult(t, tc, expectedPass, result)
 		})
 	}
 }
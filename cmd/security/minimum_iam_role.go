@@ -3,14 +3,18 @@ package security
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/ec2"
-	"github.com/aws/aws-sdk-go/service/iam"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -22,151 +26,384 @@ var allowedPolicies = map[string]bool{
 	"AmazonEKSWorkerNodePolicy":          true,
 }
 
-// GetNodeIPs는 모든 노드에서 제공된 IP 주소(provided-node-ip 어노테이션)를 수집
-func GetNodeIPs(client kubernetes.Interface) ([]string, error) {
-	nodes, err := client.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{})
-	if err != nil {
-		return nil, err
-	}
+// dangerousNodeAction 노드 역할에 허용되면 위험한 동작 (allResources가 true이면 Resource가 *일 때만)
+type dangerousNodeAction struct {
+	action       string
+	allResources bool
+}
 
-	var nodeIPs []string
-	for _, node := range nodes.Items {
-		// 노드 어노테이션 중 provided-node-ip 값을 수집
-		if ip, ok := node.Annotations["alpha.kubernetes.io/provided-node-ip"]; ok {
-			nodeIPs = append(nodeIPs, ip)
-		}
-	}
+// dangerousNodeActions 노드가 침해되면 계정 권한 상승이나 데이터 유출로 이어지는 동작
+var dangerousNodeActions = []dangerousNodeAction{
+	{action: "*"},
+	{action: "iam:*"},
+	{action: "ec2:*"},
+	{action: "s3:*", allResources: true},
+}
+
+// describeInstancesBatch DescribeInstances 한 번에 조회할 인스턴스 ID 수
+const describeInstancesBatch = 200
 
-	return nodeIPs, nil
+// NodeIAMAPI SEC-004 점검에 사용하는 IAM API
+type NodeIAMAPI interface {
+	IAMRoleAPI
+	GetInstanceProfile(ctx context.Context, params *iam.GetInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error)
 }
 
-// GetIAMRoleForNode는 주어진 노드 IP에 연결된 EC2 인스턴스의 IAM 역할 이름을 반환
-func GetIAMRoleForNode(nodeIP string) (string, error) {
-	// AWS 세션 초기화 (공유 구성 사용)
-	sess := session.Must(session.NewSessionWithOptions(session.Options{
-		SharedConfigState: session.SharedConfigEnable,
-	}))
-	svc := ec2.New(sess)
+// CheckNodeIAMRoles는 모든 노드의 IAM 역할에 허용되지 않은 정책이나 위험한 권한이 있는지 확인
+func CheckNodeIAMRoles(ctx context.Context, client kubernetes.Interface, cfg aws.Config) common.CheckResult {
+	return EvaluateNodeIAMRoles(ctx, client, ec2.NewFromConfig(cfg), iam.NewFromConfig(cfg))
+}
 
-	// EC2 인스턴스 필터링: private IP로 인스턴스를 조회
-	input := &ec2.DescribeInstancesInput{
-		Filters: []*ec2.Filter{
-			{
-				Name:   aws.String("private-ip-address"),
-				Values: []*string{aws.String(nodeIP)},
-			},
-		},
+// EvaluateNodeIAMRoles 노드의 spec.providerID 인스턴스 ID로 인스턴스 프로파일의 IAM 역할을 찾아 역할별로 점검
+// - 허용 목록 외의 관리형 정책과 인라인 정책
+// - 관리형(AWS/고객)·인라인 정책 문서에서 위험한 동작(*, iam:*, ec2:*, 모든 리소스에 대한 s3:*) 허용
+// 역할 정책의 명시적 Deny가 막거나 권한 경계(permissions boundary)가 허용하지 않는 동작은 위험한 동작으로 보지 않음 (Fargate 노드는 검사 제외)
+func EvaluateNodeIAMRoles(ctx context.Context, client kubernetes.Interface, ec2API ec2.DescribeInstancesAPIClient, api NodeIAMAPI) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-004] 데이터 플레인 노드에 필수로 필요한 IAM 권한만 부여",
+		Manual:     false,
+		Passed:     true, // 기본적으로 통과 상태로 설정, 문제 발생 시 false로 변경
+		FailureMsg: "일부 노드 IAM 역할에서 허용되지 않은 정책 또는 위험한 권한이 발견되었습니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-004", // 문제가 있을 경우 참고할 Runbook 링크
 	}
 
-	result, err := svc.DescribeInstances(input)
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
 	if err != nil {
-		return "", err
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
 	}
 
-	// 조회된 인스턴스가 없는 경우 에러 반환
-	if len(result.Reservations) == 0 || len(result.Reservations[0].Instances) == 0 {
-		return "", fmt.Errorf("no instance found for IP %s", nodeIP)
+	// 1. 노드 → EC2 인스턴스
+	instances, unresolved, err := describeNodeInstances(ctx, ec2API, nodes.Items)
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	for _, node := range unresolved {
+		result.Passed = false
+		result.Resources = append(result.Resources, fmt.Sprintf("Node: %s | providerID(%s)에서 EC2 인스턴스 ID를 확인할 수 없음", node.Name, node.Spec.ProviderID))
 	}
 
-	instance := result.Reservations[0].Instances[0]
+	// 2. 인스턴스 프로파일 → 역할 (역할별로 노드를 묶음)
+	roleNames := map[string]string{} // 인스턴스 프로파일 이름 → 역할 이름
+	nodesByRole := map[string][]string{}
+	for _, instance := range instances {
+		if instance.instance == nil || instance.instance.IamInstanceProfile == nil {
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Node: %s | 인스턴스 %s에 IAM 인스턴스 프로파일이 없음", strings.Join(instance.nodes, ", "), instance.id))
+			continue
+		}
+		profileArn := aws.ToString(instance.instance.IamInstanceProfile.Arn)
+		profileName := profileArn[strings.LastIndex(profileArn, "/")+1:]
+		roleName, ok := roleNames[profileName]
+		if !ok {
+			output, err := api.GetInstanceProfile(ctx, &iam.GetInstanceProfileInput{InstanceProfileName: aws.String(profileName)})
+			if err != nil {
+				result.Passed = false
+				result.FailureMsg = result.CheckName + " 검사 실패 : 인스턴스 프로파일 " + profileName + " 조회 실패: " + err.Error()
+				return result
+			}
+			if len(output.InstanceProfile.Roles) > 0 {
+				roleName = aws.ToString(output.InstanceProfile.Roles[0].RoleName)
+			}
+			roleNames[profileName] = roleName
+		}
+		if roleName == "" {
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Node: %s | 인스턴스 프로파일 %s에 IAM 역할이 없음", strings.Join(instance.nodes, ", "), profileName))
+			continue
+		}
+		nodesByRole[roleName] = append(nodesByRole[roleName], instance.nodes...)
+	}
 
-	// IAM 인스턴스 프로파일이 없는 경우
-	if instance.IamInstanceProfile == nil || instance.IamInstanceProfile.Arn == nil {
-		return "", fmt.Errorf("no IAM role associated with instance %s", *instance.InstanceId)
+	// 3. 역할별 정책 분석
+	var roles []string
+	for roleName := range nodesByRole {
+		roles = append(roles, roleName)
+	}
+	sort.Strings(roles)
+	for _, roleName := range roles {
+		findings, err := nodeRoleFindings(ctx, api, roleName)
+		if err != nil {
+			result.Passed = false
+			result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+			return result
+		}
+		if len(findings) == 0 {
+			continue
+		}
+		result.Passed = false
+		prefix := fmt.Sprintf("Role: %s (노드 %d개: %s)", roleName, len(nodesByRole[roleName]), summarizeNames(nodesByRole[roleName], 3))
+		for _, finding := range findings {
+			result.Resources = append(result.Resources, prefix+" | "+finding)
+		}
 	}
 
-	// IAM 인스턴스 프로파일 이름 추출 (ARN에서 마지막 부분)
-	profileArn := *instance.IamInstanceProfile.Arn
-	profileName := profileArn[strings.LastIndex(profileArn, "/")+1:]
+	return result
+}
 
-	// IAM 서비스 클라이언트 생성
-	iamSvc := iam.New(sess)
+// nodeRoleFindings 노드 역할 하나의 허용되지 않은 정책과 위험한 동작 허용
+func nodeRoleFindings(ctx context.Context, api NodeIAMAPI, roleName string) ([]string, error) {
+	role, err := getRole(ctx, api, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return []string{"역할이 존재하지 않음"}, nil
+	}
 
-	// 인스턴스 프로파일에서 역할 정보를 조회
-	profileInput := &iam.GetInstanceProfileInput{
-		InstanceProfileName: aws.String(profileName),
+	// 권한 경계가 있으면 경계가 허용하는 동작만 유효
+	var boundary *PolicyDocument
+	boundaryName := ""
+	if role.PermissionsBoundary != nil && role.PermissionsBoundary.PermissionsBoundaryArn != nil {
+		boundaryArn := aws.ToString(role.PermissionsBoundary.PermissionsBoundaryArn)
+		boundaryName = boundaryArn[strings.LastIndex(boundaryArn, "/")+1:]
+		boundary, err = managedPolicyDocument(ctx, api, boundaryArn)
+		if err != nil {
+			return nil, err
+		}
 	}
-	profileOutput, err := iamSvc.GetInstanceProfile(profileInput)
+
+	policies, err := listRolePolicies(ctx, api, roleName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get IAM instance profile details: %v", err)
+		return nil, err
 	}
 
-	// 프로파일에 역할이 없는 경우 에러
-	if len(profileOutput.InstanceProfile.Roles) == 0 {
-		return "", fmt.Errorf("no IAM role found in instance profile %s", profileName)
+	// 역할 정책의 명시적 Deny가 막는 동작은 허용으로 보지 않음
+	var denies []PolicyStatement
+	for _, policy := range policies {
+		denies = append(denies, policy.Document.Statement...)
 	}
 
-	// 역할 이름 반환
-	return *profileOutput.InstanceProfile.Roles[0].RoleName, nil
+	var findings []string
+	for _, policy := range policies {
+		switch {
+		case policy.Arn == "":
+			findings = append(findings, "인라인 정책 "+policy.Name+": 허용되지 않은 정책")
+		case !allowedPolicies[policy.Name] || !isAWSManagedPolicy(policy.Arn):
+			findings = append(findings, "정책 "+policy.Name+": 허용되지 않은 정책")
+		}
+
+		for i, statement := range policy.Document.Statement {
+			if statement.Effect != "Allow" {
+				continue
+			}
+			var reported []string
+			for _, dangerous := range dangerousNodeActions {
+				// 같은 문장에서 이미 보고한 더 넓은 동작(*)에 포함되면 생략
+				if slices.ContainsFunc(reported, func(action string) bool { return matchWildcard(action, dangerous.action) }) {
+					continue
+				}
+				pattern, granted := statementGrants(statement, dangerous.action)
+				if !granted {
+					continue
+				}
+				if dangerous.allResources && !slices.Contains(statement.Resource, "*") {
+					continue
+				}
+				if explicitlyDenied(denies, dangerous.action) {
+					continue
+				}
+				if boundary != nil && !boundaryAllows(boundary, dangerous.action) {
+					continue
+				}
+				reported = append(reported, dangerous.action)
+				finding := "정책 " + policy.Name + " 문장 " + statementLabel(statement, i) + ": " + dangerous.action + " 허용"
+				if pattern != "" {
+					finding += " (" + pattern + ")"
+				}
+				if dangerous.allResources {
+					finding += " (모든 리소스)"
+				}
+				if boundary != nil {
+					finding += " (권한 경계 " + boundaryName + "도 허용)"
+				}
+				findings = append(findings, finding)
+			}
+		}
+	}
+	return findings, nil
 }
 
-// GetAttachedPolicies는 지정된 IAM 역할에 연결된 정책 이름 목록을 반환
-func GetAttachedPolicies(roleName string) ([]string, error) {
-	sess := session.Must(session.NewSessionWithOptions(session.Options{
-		SharedConfigState: session.SharedConfigEnable,
-	}))
-	svc := iam.New(sess)
+// readOnlyActionPrefixes 서비스 전체 위험 동작으로 보지 않는 읽기 전용 동작 접두사
+var readOnlyActionPrefixes = []string{"describe", "get", "list"}
 
-	// 역할에 연결된 정책 나열
-	input := &iam.ListAttachedRolePoliciesInput{
-		RoleName: aws.String(roleName),
+// statementGrants Allow 문장이 위험 동작을 허용하는지와 근거가 된 패턴 (동작 전체를 그대로 허용하면 빈 문자열)
+// - statementCovers로 Action 와일드카드와 NotAction을 비교 (NotAction이 동작을 제외하지 않으면 "NotAction")
+// - 서비스 전체 동작(ec2:* 등)은 같은 서비스의 와일드카드 패턴(ec2:*Instance* 등)도 허용으로 판단
+// 단, Describe*, Get*, List*처럼 읽기 전용 접두사로 시작하는 패턴은 제외
+func statementGrants(statement PolicyStatement, action string) (string, bool) {
+	if statementCovers(statement, action) {
+		if len(statement.NotAction) > 0 {
+			return "NotAction", true
+		}
+		for _, pattern := range statement.Action {
+			normalized := normalizeAction(pattern)
+			if normalized == action {
+				return "", true
+			}
+			if matchWildcard(normalized, action) {
+				return pattern, true
+			}
+		}
 	}
 
-	result, err := svc.ListAttachedRolePolicies(input)
-	if err != nil {
-		return nil, err
+	service, ok := strings.CutSuffix(action, ":*")
+	if !ok || len(statement.NotAction) > 0 {
+		return "", false
 	}
+	for _, pattern := range statement.Action {
+		name, found := strings.CutPrefix(normalizeAction(pattern), service+":")
+		if !found || !strings.ContainsAny(name, "*?") {
+			continue
+		}
+		if slices.ContainsFunc(readOnlyActionPrefixes, func(prefix string) bool { return strings.HasPrefix(name, prefix) }) {
+			continue
+		}
+		return pattern, true
+	}
+	return "", false
+}
 
-	var policies []string
-	for _, policy := range result.AttachedPolicies {
-		policies = append(policies, *policy.PolicyName)
+// explicitlyDenied 조건 없이 모든 리소스(*)에 적용되는 Deny 문장이 동작을 막는지
+// 일부 리소스나 조건부 Deny는 동작 전체를 막는 것으로 보지 않음
+func explicitlyDenied(statements []PolicyStatement, action string) bool {
+	for _, statement := range statements {
+		if statement.Effect == "Deny" && len(statement.Condition) == 0 && slices.Contains(statement.Resource, "*") && statementCovers(statement, action) {
+			return true
+		}
 	}
+	return false
+}
 
-	return policies, nil
+// boundaryAllows 권한 경계가 동작을 허용하는지 (명시적 Deny를 먼저 적용한 뒤 Allow 확인)
+func boundaryAllows(boundary *PolicyDocument, action string) bool {
+	if explicitlyDenied(boundary.Statement, action) {
+		return false
+	}
+	for _, statement := range boundary.Statement {
+		if statement.Effect == "Allow" && statementCovers(statement, action) {
+			return true
+		}
+	}
+	return false
 }
 
-// CheckNodeIAMRoles는 모든 노드의 IAM 역할에 허용되지 않은 정책이 있는지 확인
-func CheckNodeIAMRoles(client kubernetes.Interface) common.CheckResult {
-	result := common.CheckResult{
-		CheckName:  "[SEC-004] 데이터 플레인 노드에 필수로 필요한 IAM 권한만 부여",
-		Manual:     false,
-		Passed:     true, // 기본적으로 통과 상태로 설정, 문제 발생 시 false로 변경
-		FailureMsg: "일부 노드에서 허용되지 않은 IAM 정책이 발견되었습니다.",
-		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-004", // 문제가 있을 경우 참고할 Runbook 링크
+// statementCovers 문장의 Action이 동작을 포함하거나 NotAction이 동작을 제외하지 않는지 (와일드카드 패턴으로 비교)
+func statementCovers(statement PolicyStatement, action string) bool {
+	matches := func(patterns []string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool { return matchWildcard(normalizeAction(pattern), action) })
+	}
+	if len(statement.NotAction) > 0 {
+		return !matches(statement.NotAction)
 	}
+	return matches(statement.Action)
+}
 
-	// 노드 IP 목록 가져오기
-	nodeIPs, err := GetNodeIPs(client)
-	if err != nil {
-		result.Passed = false
-		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
-		return result
+// normalizeAction 대소문자를 무시하도록 소문자로 바꾸고 *:*는 *로 취급
+func normalizeAction(action string) string {
+	action = strings.ToLower(action)
+	if action == "*:*" {
+		return "*"
 	}
+	return action
+}
 
-	// 각 노드에 대해 IAM 역할 및 정책 확인
-	for _, ip := range nodeIPs {
-		roleName, err := GetIAMRoleForNode(ip)
-		if err != nil {
-			result.Passed = false
-			result.Resources = append(result.Resources, fmt.Sprintf("Node IP: %s, Error: %v", ip, err))
-			continue
+// matchWildcard IAM 와일드카드(*, ?) 패턴 비교
+func matchWildcard(pattern, value string) bool {
+	if pattern == "" {
+		return value == ""
+	}
+	switch pattern[0] {
+	case '*':
+		for i := 0; i <= len(value); i++ {
+			if matchWildcard(pattern[1:], value[i:]) {
+				return true
+			}
 		}
+		return false
+	case '?':
+		return value != "" && matchWildcard(pattern[1:], value[1:])
+	default:
+		return value != "" && pattern[0] == value[0] && matchWildcard(pattern[1:], value[1:])
+	}
+}
 
-		policies, err := GetAttachedPolicies(roleName)
-		if err != nil {
-			result.Passed = false
-			result.Resources = append(result.Resources, fmt.Sprintf("Role: %s, Error: %v", roleName, err))
+// nodeInstance EC2 인스턴스와 그 인스턴스의 노드
+type nodeInstance struct {
+	id       string
+	nodes    []string
+	instance *ec2types.Instance // 조회되지 않으면 nil
+}
+
+// describeNodeInstances 노드의 spec.providerID 인스턴스 ID로 EC2 인스턴스 조회 (Fargate 노드 제외)
+// 결과는 노드 목록 순서를 따르며, providerID에서 인스턴스 ID를 찾을 수 없는 노드는 따로 반환
+func describeNodeInstances(ctx context.Context, api ec2.DescribeInstancesAPIClient, nodes []corev1.Node) ([]*nodeInstance, []corev1.Node, error) {
+	var instances []*nodeInstance
+	var unresolved []corev1.Node
+	byID := map[string]*nodeInstance{}
+	for _, node := range nodes {
+		if isFargateNode(node) {
+			continue
+		}
+		instanceID := instanceIDFromProviderID(node.Spec.ProviderID)
+		if instanceID == "" {
+			unresolved = append(unresolved, node)
 			continue
 		}
+		if _, ok := byID[instanceID]; !ok {
+			byID[instanceID] = &nodeInstance{id: instanceID}
+			instances = append(instances, byID[instanceID])
+		}
+		byID[instanceID].nodes = append(byID[instanceID].nodes, node.Name)
+	}
 
-		// 허용되지 않은 정책이 포함되어 있는지 검사
-		for _, policy := range policies {
-			if !allowedPolicies[policy] {
-				result.Passed = false
-				result.Resources = append(result.Resources, fmt.Sprintf("Role: %s, Unauthorized Policy: %s", roleName, policy))
+	for start := 0; start < len(instances); start += describeInstancesBatch {
+		var ids []string
+		for _, instance := range instances[start:min(start+describeInstancesBatch, len(instances))] {
+			ids = append(ids, instance.id)
+		}
+		paginator := ec2.NewDescribeInstancesPaginator(api, &ec2.DescribeInstancesInput{InstanceIds: ids})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, nil, fmt.Errorf("인스턴스 조회 실패: %w", err)
+			}
+			for _, reservation := range page.Reservations {
+				for i := range reservation.Instances {
+					if instance, ok := byID[aws.ToString(reservation.Instances[i].InstanceId)]; ok {
+						instance.instance = &reservation.Instances[i]
+					}
+				}
 			}
 		}
 	}
+	return instances, unresolved, nil
+}
 
-	return result
+// summarizeNames 이름 목록 표시 (limit개를 넘으면 "외 N개"로 줄임)
+func summarizeNames(names []string, limit int) string {
+	if len(names) <= limit {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s 외 %d개", strings.Join(names[:limit], ", "), len(names)-limit)
+}
+
+// instanceIDFromProviderID spec.providerID(aws:///<가용 영역>/<인스턴스 ID>)에서 EC2 인스턴스 ID 추출
+func instanceIDFromProviderID(providerID string) string {
+	if !strings.HasPrefix(providerID, "aws://") {
+		return ""
+	}
+	id := providerID[strings.LastIndex(providerID, "/")+1:]
+	if !strings.HasPrefix(id, "i-") {
+		return ""
+	}
+	return id
+}
+
+// isFargateNode Fargate 노드 여부 (노드 역할 대신 Pod 실행 역할 사용)
+func isFargateNode(node corev1.Node) bool {
+	return node.Labels["eks.amazonaws.com/compute-type"] == "fargate" || strings.HasPrefix(node.Name, "fargate-")
 }
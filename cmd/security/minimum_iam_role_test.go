@@ -2,19 +2,71 @@ package security_test
 
 import (
 	"context"
-	"fmt"
+	"net/url"
+	"strings"
 	"testing"
 
 	"eks-checklist/cmd/security"
 	"eks-checklist/cmd/testutils"
 
-	corev1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes/fake"
-
-	"bou.ke/monkey"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
+// fakeNodeInstances 인스턴스 ID → 인스턴스 프로파일 ARN을 돌려주는 EC2 API
+type fakeNodeInstances map[string]string
+
+func (f fakeNodeInstances) DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	reservation := ec2types.Reservation{}
+	for _, id := range input.InstanceIds {
+		instance := ec2types.Instance{InstanceId: aws.String(id)}
+		if profile := f[id]; profile != "" {
+			instance.IamInstanceProfile = &ec2types.IamInstanceProfile{Arn: aws.String(profile)}
+		}
+		reservation.Instances = append(reservation.Instances, instance)
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{reservation}}, nil
+}
+
+// fakeNodeIAMAPI 인스턴스 프로파일과 권한 경계를 추가한 IAM API
+type fakeNodeIAMAPI struct {
+	fakeIRSAAPI
+	profiles   map[string]string // 인스턴스 프로파일 이름 → 역할 이름
+	boundaries map[string]string // 역할 이름 → 권한 경계 정책 문서
+}
+
+func (f fakeNodeIAMAPI) GetInstanceProfile(ctx context.Context, input *iam.GetInstanceProfileInput, optFns ...func(*iam.Options)) (*iam.GetInstanceProfileOutput, error) {
+	profile := &iamtypes.InstanceProfile{InstanceProfileName: input.InstanceProfileName}
+	if role, ok := f.profiles[aws.ToString(input.InstanceProfileName)]; ok {
+		profile.Roles = []iamtypes.Role{{RoleName: aws.String(role)}}
+	}
+	return &iam.GetInstanceProfileOutput{InstanceProfile: profile}, nil
+}
+
+func (f fakeNodeIAMAPI) GetRole(ctx context.Context, input *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	output, err := f.fakeIRSAAPI.GetRole(ctx, input, optFns...)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := f.boundaries[aws.ToString(input.RoleName)]; ok {
+		output.Role.PermissionsBoundary = &iamtypes.AttachedPermissionsBoundary{
+			PermissionsBoundaryArn: aws.String("arn:aws:iam::123456789012:policy/boundary-" + aws.ToString(input.RoleName)),
+		}
+	}
+	return output, nil
+}
+
+func (f fakeNodeIAMAPI) GetPolicyVersion(ctx context.Context, input *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error) {
+	arn := aws.ToString(input.PolicyArn)
+	if role, ok := strings.CutPrefix(arn, "arn:aws:iam::123456789012:policy/boundary-"); ok {
+		return &iam.GetPolicyVersionOutput{PolicyVersion: &iamtypes.PolicyVersion{Document: aws.String(url.PathEscape(f.boundaries[role]))}}, nil
+	}
+	return f.fakeIRSAAPI.GetPolicyVersion(ctx, input, optFns...)
+}
+
 func TestCheckNodeIAMRoles_YAML(t *testing.T) {
 	// YAML 파일 "check_node_iam_roles.yaml"에서 테스트 케이스 로드
 	testCases := testutils.LoadTestCases(t, "check_node_iam_roles.yaml")
@@ -22,109 +74,32 @@ func TestCheckNodeIAMRoles_YAML(t *testing.T) {
 		testName := tc["name"].(string)
 		expectPass := tc["expected"].(bool)
 
-		// YAML의 nodes 항목 읽기
-		nodesRaw, ok := tc["nodes"].([]interface{})
-		if !ok {
-			t.Fatalf("Test case '%s' missing 'nodes' field", testName)
-		}
-
-		// YAML의 iam_roles 항목 읽기
-		iamRolesRaw, ok := tc["iam_roles"].(map[string]interface{})
-		if !ok {
-			t.Fatalf("Test case '%s' missing 'iam_roles' field", testName)
-		}
-		// iam_roles: map[string] => { role: string, policies: []string }
-		type IamRoleInfo struct {
-			Role     string
-			Policies []string
-		}
-		iamRoles := make(map[string]IamRoleInfo)
-		for ip, raw := range iamRolesRaw {
-			m, ok := raw.(map[string]interface{})
-			if !ok {
-				t.Fatalf("Test case '%s': iam_roles for ip %s is not a map", testName, ip)
-			}
-			role, ok := m["role"].(string)
-			if !ok {
-				t.Fatalf("Test case '%s': iam_roles for ip %s missing role", testName, ip)
-			}
-			policiesRaw, ok := m["policies"].([]interface{})
-			if !ok {
-				t.Fatalf("Test case '%s': iam_roles for ip %s missing policies", testName, ip)
-			}
-			var policies []string
-			for _, p := range policiesRaw {
-				pStr, ok := p.(string)
-				if !ok {
-					t.Fatalf("Test case '%s': policy value is not a string", testName)
-				}
-				policies = append(policies, pStr)
-			}
-			iamRoles[ip] = IamRoleInfo{
-				Role:     role,
-				Policies: policies,
-			}
-		}
-
 		t.Run(testName, func(t *testing.T) {
-			client := fake.NewSimpleClientset()
+			set := testutils.LoadManifests(t, tc["objects"])
 
-			// YAML에 정의된 Node 객체 생성
-			// Node는 클러스터 범위 리소스이므로 네임스페이스는 설정하지 않습니다.
-			for _, n := range nodesRaw {
-				nodeMap, ok := n.(map[string]interface{})
-				if !ok {
-					t.Fatalf("Test case '%s': node is not a map", testName)
-				}
-				// YAML에 namespace 필드가 있더라도 무시합니다.
-				name, ok := nodeMap["name"].(string)
-				if !ok {
-					t.Fatalf("Test case '%s': node missing 'name'", testName)
-				}
-				ip, ok := nodeMap["provided_node_ip"].(string)
-				if !ok {
-					t.Fatalf("Test case '%s': node missing 'provided_node_ip'", testName)
-				}
-				node := &corev1.Node{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: name,
-						Annotations: map[string]string{
-							"alpha.kubernetes.io/provided-node-ip": ip,
-						},
-					},
-				}
-				_, err := client.CoreV1().Nodes().Create(context.TODO(), node, metav1.CreateOptions{})
-				if err != nil {
-					t.Fatalf("Test case '%s': failed to create node %s: %v", testName, name, err)
-				}
+			instances := fakeNodeInstances(toStringMap(tc["instances"]))
+			api := fakeNodeIAMAPI{
+				fakeIRSAAPI: fakeIRSAAPI{roles: map[string]fakeIAMRole{}},
+				profiles:    toStringMap(tc["instance_profiles"]),
+				boundaries:  map[string]string{},
 			}
-
-			// Patch GetIAMRoleForNode: node IP를 키로 iamRoles mapping에서 Role을 반환하도록 함.
-			patch1 := monkey.Patch(security.GetIAMRoleForNode, func(nodeIP string) (string, error) {
-				info, exists := iamRoles[nodeIP]
-				if !exists {
-					return "", fmt.Errorf("no iam role info for node ip %s", nodeIP)
-				}
-				return info.Role, nil
-			})
-			defer patch1.Unpatch()
-
-			// Patch GetAttachedPolicies: role 이름에 해당하는 정책 목록을 반환하도록 함.
-			patch2 := monkey.Patch(security.GetAttachedPolicies, func(roleName string) ([]string, error) {
-				for _, info := range iamRoles {
-					if info.Role == roleName {
-						return info.Policies, nil
+			if roles, ok := tc["roles"].(map[string]interface{}); ok {
+				for name, raw := range roles {
+					role := raw.(map[string]interface{})
+					api.roles[name] = fakeIAMRole{
+						trust:    `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"ec2.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+						attached: toStringMap(role["attached"]),
+						inline:   toStringMap(role["inline"]),
+					}
+					if boundary, ok := role["boundary"].(string); ok {
+						api.boundaries[name] = boundary
 					}
 				}
-				return nil, fmt.Errorf("no policies for role %s", roleName)
-			})
-			defer patch2.Unpatch()
+			}
 
 			// 함수 실행 및 반환값 비교
-			result := security.CheckNodeIAMRoles(client)
-			if result.Passed != expectPass {
-				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result)
-			}
+			result := security.EvaluateNodeIAMRoles(context.Background(), set.Clientset(), instances, api)
+			testutils.AssertCheckResult(t, tc, expectPass, result)
 		})
 	}
 }
@@ -10,9 +10,10 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 )
 
-func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster string) common.CheckResult {
+func CheckMultitenancy(ctx context.Context, client kubernetes.Interface, cfg aws.Config, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-006] 멀티 태넌시 적용 유무",
 		Manual:     true,
@@ -21,16 +22,13 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-006",
 	}
 
-	baseDir := filepath.Join(".", "output", eksCluster+"-multitenancy")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
 		return result
 	}
 
-	ctx := context.TODO()
-
 	// 1. Namespaces
-	if ns, err := client.CoreV1().Namespaces().List(ctx, v1.ListOptions{}); err == nil {
+	if ns, err := kube.ListAll(ctx, client.CoreV1().Namespaces().List, v1.ListOptions{}); err == nil {
 		path := filepath.Join(baseDir, "namespaces.json")
 		if err := common.SaveAsJSON(ns.Items, path); err == nil {
 			result.Resources = append(result.Resources, "네임스페이스 목록: "+path)
@@ -38,7 +36,7 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 	}
 
 	// 2. NetworkPolicy
-	if np, err := client.NetworkingV1().NetworkPolicies("").List(ctx, v1.ListOptions{}); err == nil {
+	if np, err := kube.ListAll(ctx, client.NetworkingV1().NetworkPolicies("").List, v1.ListOptions{}); err == nil {
 		path := filepath.Join(baseDir, "network_policies.json")
 		if err := common.SaveAsJSON(np.Items, path); err == nil {
 			result.Resources = append(result.Resources, "네트워크 정책 목록: "+path)
@@ -47,10 +45,10 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 
 	// 3. RBAC (RoleBinding + ClusterRoleBinding)
 	var rbacData []interface{}
-	if rb, err := client.RbacV1().RoleBindings("").List(ctx, v1.ListOptions{}); err == nil {
+	if rb, err := kube.ListAll(ctx, client.RbacV1().RoleBindings("").List, v1.ListOptions{}); err == nil {
 		rbacData = append(rbacData, rb.Items)
 	}
-	if crb, err := client.RbacV1().ClusterRoleBindings().List(ctx, v1.ListOptions{}); err == nil {
+	if crb, err := kube.ListAll(ctx, client.RbacV1().ClusterRoleBindings().List, v1.ListOptions{}); err == nil {
 		rbacData = append(rbacData, crb.Items)
 	}
 	if len(rbacData) > 0 {
@@ -61,7 +59,7 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 	}
 
 	// 4. ResourceQuota
-	if rq, err := client.CoreV1().ResourceQuotas("").List(ctx, v1.ListOptions{}); err == nil {
+	if rq, err := kube.ListAll(ctx, client.CoreV1().ResourceQuotas("").List, v1.ListOptions{}); err == nil {
 		path := filepath.Join(baseDir, "resource_quotas.json")
 		if err := common.SaveAsJSON(rq.Items, path); err == nil {
 			result.Resources = append(result.Resources, "리소스 쿼터 목록: "+path)
@@ -69,7 +67,7 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 	}
 
 	// 5. LimitRange
-	if lr, err := client.CoreV1().LimitRanges("").List(ctx, v1.ListOptions{}); err == nil {
+	if lr, err := kube.ListAll(ctx, client.CoreV1().LimitRanges("").List, v1.ListOptions{}); err == nil {
 		path := filepath.Join(baseDir, "limit_ranges.json")
 		if err := common.SaveAsJSON(lr.Items, path); err == nil {
 			result.Resources = append(result.Resources, "LimitRange 목록: "+path)
@@ -77,7 +75,7 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 	}
 
 	// 6. IRSA (ServiceAccounts with role annotation)
-	if saList, err := client.CoreV1().ServiceAccounts("").List(ctx, v1.ListOptions{}); err == nil {
+	if saList, err := kube.ListAll(ctx, client.CoreV1().ServiceAccounts("").List, v1.ListOptions{}); err == nil {
 		var irsaList []interface{}
 		for _, sa := range saList.Items {
 			if _, ok := sa.Annotations["eks.amazonaws.com/role-arn"]; ok {
@@ -93,7 +91,7 @@ func CheckMultitenancy(client kubernetes.Interface, cfg aws.Config, eksCluster s
 	}
 
 	// 7. PriorityClasses
-	if pc, err := client.SchedulingV1().PriorityClasses().List(ctx, v1.ListOptions{}); err == nil {
+	if pc, err := kube.ListAll(ctx, client.SchedulingV1().PriorityClasses().List, v1.ListOptions{}); err == nil {
 		path := filepath.Join(baseDir, "priority_classes.json")
 		if err := common.SaveAsJSON(pc.Items, path); err == nil {
 			result.Resources = append(result.Resources, "PriorityClass 목록: "+path)
@@ -0,0 +1,424 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// PSSBaseline 알려진 권한 상승을 막는 최소 제한 프로필
+	PSSBaseline = "baseline"
+	// PSSRestricted Pod 보안 모범 사례를 모두 적용한 프로필
+	PSSRestricted = "restricted"
+	// PSSPrivileged 제한이 없는 프로필 (baseline을 충족하지 못한 워크로드의 수준)
+	PSSPrivileged = "privileged"
+
+	// PSSEnforceLabel 네임스페이스에 적용할 Pod Security Admission 레이블
+	PSSEnforceLabel = "pod-security.kubernetes.io/enforce"
+
+	appArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+)
+
+// baselineCapabilities baseline에서 추가(add)를 허용하는 capability
+var baselineCapabilities = []string{
+	"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD",
+	"NET_BIND_SERVICE", "SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+}
+
+// safeSysctls baseline에서 허용하는 sysctl
+var safeSysctls = []string{
+	"kernel.shm_rmid_forced",
+	"net.ipv4.ip_local_port_range",
+	"net.ipv4.ip_local_reserved_ports",
+	"net.ipv4.ip_unprivileged_port_start",
+	"net.ipv4.ping_group_range",
+	"net.ipv4.tcp_syncookies",
+	"net.ipv4.tcp_keepalive_time",
+	"net.ipv4.tcp_fin_timeout",
+	"net.ipv4.tcp_keepalive_intvl",
+	"net.ipv4.tcp_keepalive_probes",
+}
+
+// PSS 네임스페이스 레이블 점검에서 제외하는 시스템 네임스페이스
+var pssSystemNamespaces = []string{"kube-system", "kube-public", "kube-node-lease"}
+
+// podSecurityOwnerKinds 워크로드 목록에서 직접 평가하므로 Pod 단위로 평가하지 않는 컨트롤러
+var podSecurityOwnerKinds = []string{"ReplicaSet", "Deployment", "StatefulSet", "DaemonSet", "Job", "CronJob"}
+
+// baselineSELinuxTypes baseline에서 허용하는 SELinux type (빈 값은 미지정)
+var baselineSELinuxTypes = []string{"", "container_t", "container_init_t", "container_kvm_t", "container_engine_t"}
+
+// PodSecurityViolation Pod Security Standards 위반 항목
+type PodSecurityViolation struct {
+	// Level 위반한 프로필 (baseline, restricted)
+	Level   string
+	Problem string
+}
+
+// podWorkload Pod 템플릿을 가진 워크로드
+type podWorkload struct {
+	kind      string
+	namespace string
+	name      string
+	template  corev1.PodTemplateSpec
+}
+
+// CheckPodSecurityStandards 워크로드의 Pod 템플릿을 Pod Security Standards baseline/restricted 프로필로 평가하고
+// 네임스페이스의 pod-security.kubernetes.io/enforce 레이블 확인
+// - 워크로드(Deployment, StatefulSet, DaemonSet, CronJob, Deployment/CronJob이 소유하지 않은 ReplicaSet/Job, 소유자가 없는 Pod, 그 외 컨트롤러의 Pod)별로 위반 항목과 위반한 수준 표시
+// - kube-system 네임스페이스와 Windows Pod는 검사 제외
+func CheckPodSecurityStandards(ctx context.Context, client kubernetes.Interface) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-016] Pod Security Standards 적용",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "Pod Security Standards(baseline/restricted)를 위반한 워크로드 또는 pod-security.kubernetes.io/enforce 레이블이 없는 네임스페이스가 있습니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-016",
+	}
+
+	workloads, err := listPodWorkloads(ctx, client)
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+
+	for _, w := range workloads {
+		if w.namespace == "kube-system" {
+			continue // kube-system 네임스페이스는 검사 제외
+		}
+		if w.template.Spec.OS != nil && w.template.Spec.OS.Name == corev1.Windows {
+			continue
+		}
+
+		violations := PodSecurityViolations(w.template.Annotations, w.template.Spec)
+		if len(violations) == 0 {
+			continue
+		}
+		result.Passed = false
+		result.Resources = append(result.Resources, formatPodSecurityViolations(w, violations))
+	}
+
+	namespaces, err := kube.ListAll(ctx, client.CoreV1().Namespaces().List, v1.ListOptions{})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	for _, ns := range namespaces.Items {
+		if slices.Contains(pssSystemNamespaces, ns.Name) {
+			continue
+		}
+		switch level, ok := ns.Labels[PSSEnforceLabel]; {
+		case !ok:
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Namespace: %s | %s 레이블 없음", ns.Name, PSSEnforceLabel))
+		case level == PSSPrivileged:
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Namespace: %s | %s=%s (제한 없음)", ns.Name, PSSEnforceLabel, level))
+		default:
+			result.Resources = append(result.Resources, fmt.Sprintf("Namespace: %s | %s=%s", ns.Name, PSSEnforceLabel, level))
+		}
+	}
+
+	return result
+}
+
+// listPodWorkloads Pod 템플릿을 가진 워크로드 목록
+// Deployment가 소유한 ReplicaSet, CronJob이 소유한 Job, 위 워크로드가 소유한 Pod는 상위 워크로드로 평가
+// 그 외 컨트롤러(Argo Rollouts 등)가 소유한 ReplicaSet/Job은 직접 평가하고, 알 수 없는 컨트롤러의 Pod는 컨트롤러별로 하나만 평가
+func listPodWorkloads(ctx context.Context, client kubernetes.Interface) ([]podWorkload, error) {
+	var workloads []podWorkload
+
+	deployments, err := kube.ListAll(ctx, client.AppsV1().Deployments("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Deployment 목록 조회 실패: %w", err)
+	}
+	for _, d := range deployments.Items {
+		workloads = append(workloads, podWorkload{"Deployment", d.Namespace, d.Name, d.Spec.Template})
+	}
+
+	statefulSets, err := kube.ListAll(ctx, client.AppsV1().StatefulSets("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("StatefulSet 목록 조회 실패: %w", err)
+	}
+	for _, s := range statefulSets.Items {
+		workloads = append(workloads, podWorkload{"StatefulSet", s.Namespace, s.Name, s.Spec.Template})
+	}
+
+	daemonSets, err := kube.ListAll(ctx, client.AppsV1().DaemonSets("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("DaemonSet 목록 조회 실패: %w", err)
+	}
+	for _, d := range daemonSets.Items {
+		workloads = append(workloads, podWorkload{"DaemonSet", d.Namespace, d.Name, d.Spec.Template})
+	}
+
+	replicaSets, err := kube.ListAll(ctx, client.AppsV1().ReplicaSets("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ReplicaSet 목록 조회 실패: %w", err)
+	}
+	for _, r := range replicaSets.Items {
+		owner := v1.GetControllerOf(&r)
+		if owner != nil && owner.Kind == "Deployment" {
+			continue
+		}
+		// 다른 컨트롤러가 남겨 둔 이전 리비전(replicas: 0)은 실행 중인 Pod가 없으므로 제외
+		if owner != nil && r.Spec.Replicas != nil && *r.Spec.Replicas == 0 {
+			continue
+		}
+		workloads = append(workloads, podWorkload{"ReplicaSet", r.Namespace, r.Name, r.Spec.Template})
+	}
+
+	cronJobs, err := kube.ListAll(ctx, client.BatchV1().CronJobs("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("CronJob 목록 조회 실패: %w", err)
+	}
+	for _, c := range cronJobs.Items {
+		workloads = append(workloads, podWorkload{"CronJob", c.Namespace, c.Name, c.Spec.JobTemplate.Spec.Template})
+	}
+
+	jobs, err := kube.ListAll(ctx, client.BatchV1().Jobs("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Job 목록 조회 실패: %w", err)
+	}
+	for _, j := range jobs.Items {
+		if owner := v1.GetControllerOf(&j); owner == nil || owner.Kind != "CronJob" {
+			workloads = append(workloads, podWorkload{"Job", j.Namespace, j.Name, j.Spec.Template})
+		}
+	}
+
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Pod 목록 조회 실패: %w", err)
+	}
+	seenOwners := map[string]bool{}
+	for _, pod := range pods.Items {
+		template := corev1.PodTemplateSpec{ObjectMeta: pod.ObjectMeta, Spec: pod.Spec}
+		owner := v1.GetControllerOf(&pod)
+		if owner == nil {
+			workloads = append(workloads, podWorkload{"Pod", pod.Namespace, pod.Name, template})
+			continue
+		}
+		if slices.Contains(podSecurityOwnerKinds, owner.Kind) {
+			continue
+		}
+		key := pod.Namespace + "/" + owner.Kind + "/" + owner.Name
+		if seenOwners[key] {
+			continue
+		}
+		seenOwners[key] = true
+		workloads = append(workloads, podWorkload{owner.Kind, pod.Namespace, owner.Name, template})
+	}
+
+	return workloads, nil
+}
+
+// PodSecurityViolations Pod 템플릿의 Pod Security Standards 위반 항목 (init 컨테이너 포함)
+// annotations는 AppArmor 어노테이션(container.apparmor.security.beta.kubernetes.io/<컨테이너>) 확인에 사용
+func PodSecurityViolations(annotations map[string]string, spec corev1.PodSpec) []PodSecurityViolation {
+	var violations []PodSecurityViolation
+	add := func(level, problem string) {
+		violations = append(violations, PodSecurityViolation{Level: level, Problem: problem})
+	}
+
+	// ---------------------------------------
+	// baseline
+	// ---------------------------------------
+	if spec.HostNetwork {
+		add(PSSBaseline, "hostNetwork 사용")
+	}
+	if spec.HostPID {
+		add(PSSBaseline, "hostPID 사용")
+	}
+	if spec.HostIPC {
+		add(PSSBaseline, "hostIPC 사용")
+	}
+	for _, volume := range spec.Volumes {
+		if volume.HostPath != nil {
+			add(PSSBaseline, fmt.Sprintf("hostPath 볼륨(%s: %s)", volume.Name, volume.HostPath.Path))
+		}
+	}
+	var podSC corev1.PodSecurityContext
+	if spec.SecurityContext != nil {
+		podSC = *spec.SecurityContext
+	}
+	for _, sysctl := range podSC.Sysctls {
+		if !slices.Contains(safeSysctls, sysctl.Name) {
+			add(PSSBaseline, "허용되지 않은 sysctl("+sysctl.Name+")")
+		}
+	}
+	if unsafeSeccomp(podSC.SeccompProfile) {
+		add(PSSBaseline, "Pod seccomp 프로필 Unconfined")
+	}
+	if unsafeAppArmor(podSC.AppArmorProfile) {
+		add(PSSBaseline, "Pod AppArmor 프로필 Unconfined")
+	}
+	if problem := unsafeSELinux(podSC.SELinuxOptions); problem != "" {
+		add(PSSBaseline, "Pod "+problem)
+	}
+
+	containers := append(slices.Clone(spec.InitContainers), spec.Containers...)
+	for _, c := range containers {
+		sc := c.SecurityContext
+		if sc == nil {
+			sc = &corev1.SecurityContext{}
+		}
+		if sc.Privileged != nil && *sc.Privileged {
+			add(PSSBaseline, "privileged 컨테이너("+c.Name+")")
+		}
+		if sc.WindowsOptions != nil && sc.WindowsOptions.HostProcess != nil && *sc.WindowsOptions.HostProcess {
+			add(PSSBaseline, "Windows HostProcess 컨테이너("+c.Name+")")
+		}
+		for _, port := range c.Ports {
+			if port.HostPort != 0 {
+				add(PSSBaseline, fmt.Sprintf("hostPort %d(%s)", port.HostPort, c.Name))
+			}
+		}
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if !slices.Contains(baselineCapabilities, string(capability)) {
+					add(PSSBaseline, fmt.Sprintf("capability %s 추가(%s)", capability, c.Name))
+				}
+			}
+		}
+		if sc.ProcMount != nil && *sc.ProcMount != corev1.DefaultProcMount {
+			add(PSSBaseline, fmt.Sprintf("procMount %s(%s)", *sc.ProcMount, c.Name))
+		}
+		if unsafeSeccomp(sc.SeccompProfile) {
+			add(PSSBaseline, "seccomp 프로필 Unconfined("+c.Name+")")
+		}
+		if unsafeAppArmor(sc.AppArmorProfile) || annotations[appArmorAnnotationPrefix+c.Name] == "unconfined" {
+			add(PSSBaseline, "AppArmor 프로필 Unconfined("+c.Name+")")
+		}
+		if problem := unsafeSELinux(sc.SELinuxOptions); problem != "" {
+			add(PSSBaseline, problem+"("+c.Name+")")
+		}
+	}
+
+	// ---------------------------------------
+	// restricted
+	// ---------------------------------------
+	for _, volume := range spec.Volumes {
+		if volume.HostPath == nil && !restrictedVolume(volume) {
+			add(PSSRestricted, "허용되지 않은 볼륨 유형("+volume.Name+")")
+		}
+	}
+	if podSC.RunAsUser != nil && *podSC.RunAsUser == 0 {
+		add(PSSRestricted, "Pod runAsUser 0")
+	}
+	for _, c := range containers {
+		sc := c.SecurityContext
+		if sc == nil {
+			sc = &corev1.SecurityContext{}
+		}
+		if sc.AllowPrivilegeEscalation == nil || *sc.AllowPrivilegeEscalation {
+			add(PSSRestricted, "allowPrivilegeEscalation이 false가 아님("+c.Name+")")
+		}
+		runAsNonRoot := podSC.RunAsNonRoot
+		if sc.RunAsNonRoot != nil {
+			runAsNonRoot = sc.RunAsNonRoot
+		}
+		if runAsNonRoot == nil || !*runAsNonRoot {
+			add(PSSRestricted, "runAsNonRoot가 true가 아님("+c.Name+")")
+		}
+		if sc.RunAsUser != nil && *sc.RunAsUser == 0 {
+			add(PSSRestricted, "runAsUser 0("+c.Name+")")
+		}
+		seccomp := podSC.SeccompProfile
+		if sc.SeccompProfile != nil {
+			seccomp = sc.SeccompProfile
+		}
+		if seccomp == nil {
+			add(PSSRestricted, "seccomp 프로필(RuntimeDefault 또는 Localhost) 미지정("+c.Name+")")
+		}
+		if sc.Capabilities == nil || !slices.Contains(sc.Capabilities.Drop, "ALL") {
+			add(PSSRestricted, "capabilities drop: ALL 미지정("+c.Name+")")
+		}
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				if capability != "NET_BIND_SERVICE" && slices.Contains(baselineCapabilities, string(capability)) {
+					add(PSSRestricted, fmt.Sprintf("capability %s 추가(%s)", capability, c.Name))
+				}
+			}
+		}
+	}
+
+	return violations
+}
+
+// PodSecurityLevel 위반 항목으로 판단한 워크로드의 Pod Security Standards 수준
+func PodSecurityLevel(violations []PodSecurityViolation) string {
+	level := PSSRestricted
+	for _, v := range violations {
+		if v.Level == PSSBaseline {
+			return PSSPrivileged
+		}
+		level = PSSBaseline
+	}
+	return level
+}
+
+func formatPodSecurityViolations(w podWorkload, violations []PodSecurityViolation) string {
+	var baseline, restricted []string
+	for _, v := range violations {
+		if v.Level == PSSBaseline {
+			baseline = append(baseline, v.Problem)
+		} else {
+			restricted = append(restricted, v.Problem)
+		}
+	}
+	line := fmt.Sprintf("Namespace: %s | %s: %s | 충족 수준: %s", w.namespace, w.kind, w.name, PodSecurityLevel(violations))
+	if len(baseline) > 0 {
+		line += " | baseline 위반: " + strings.Join(baseline, ", ")
+	}
+	if len(restricted) > 0 {
+		line += " | restricted 위반: " + strings.Join(restricted, ", ")
+	}
+	return line
+}
+
+func unsafeSeccomp(profile *corev1.SeccompProfile) bool {
+	return profile != nil && profile.Type == corev1.SeccompProfileTypeUnconfined
+}
+
+func unsafeAppArmor(profile *corev1.AppArmorProfile) bool {
+	return profile != nil && profile.Type == corev1.AppArmorProfileTypeUnconfined
+}
+
+// unsafeSELinux baseline에서 허용하지 않는 SELinux 옵션 (허용되지 않은 type, user/role 지정)
+func unsafeSELinux(options *corev1.SELinuxOptions) string {
+	if options == nil {
+		return ""
+	}
+	var problems []string
+	if !slices.Contains(baselineSELinuxTypes, options.Type) {
+		problems = append(problems, "type "+options.Type)
+	}
+	if options.User != "" {
+		problems = append(problems, "user "+options.User)
+	}
+	if options.Role != "" {
+		problems = append(problems, "role "+options.Role)
+	}
+	if len(problems) == 0 {
+		return ""
+	}
+	return "허용되지 않은 SELinux 옵션 " + strings.Join(problems, ", ")
+}
+
+// restrictedVolume restricted에서 허용하는 볼륨 유형 여부
+func restrictedVolume(volume corev1.Volume) bool {
+	s := volume.VolumeSource
+	return s.ConfigMap != nil || s.CSI != nil || s.DownwardAPI != nil || s.EmptyDir != nil ||
+		s.Ephemeral != nil || s.PersistentVolumeClaim != nil || s.Projected != nil || s.Secret != nil
+}
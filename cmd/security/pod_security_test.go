@@ -0,0 +1,21 @@
+package security_test
+
+import (
+	"context"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+)
+
+func TestCheckPodSecurityStandards(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "pod_security.yaml")
+
+	for _, tc := range testCases {
+		t.Run(tc["name"].(string), func(t *testing.T) {
+			set := testutils.LoadManifests(t, tc["objects"])
+			result := security.CheckPodSecurityStandards(context.Background(), set.Clientset())
+			testutils.AssertCheckResult(t, tc, tc["expect_pass"].(bool), result)
+		})
+	}
+}
@@ -7,13 +7,14 @@ import (
 	"path/filepath"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckPodToPodNetworkPolicy checks whether NetworkPolicies exist for pod-to-pod communication.
-func CheckPodToPodNetworkPolicy(client kubernetes.Interface, eksCluster string) common.CheckResult {
+func CheckPodToPodNetworkPolicy(ctx context.Context, client kubernetes.Interface, baseDir string) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-009] Pod-to-Pod 접근 제어",
 		Manual:     true,
@@ -23,7 +24,7 @@ func CheckPodToPodNetworkPolicy(client kubernetes.Interface, eksCluster string)
 	}
 
 	// 1. NetworkPolicy 목록 조회
-	npList, err := client.NetworkingV1().NetworkPolicies("").List(context.TODO(), metav1.ListOptions{})
+	npList, err := kube.ListAll(ctx, client.NetworkingV1().NetworkPolicies("").List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -37,7 +38,6 @@ func CheckPodToPodNetworkPolicy(client kubernetes.Interface, eksCluster string)
 	}
 
 	// 2. 결과 저장 디렉토리 생성
-	baseDir := filepath.Join(".", "output", eksCluster+"-pod-network-policy")
 	if err := os.MkdirAll(baseDir, os.ModePerm); err != nil {
 		result.Passed = false
 		result.FailureMsg = "결과 디렉토리 생성 실패: " + err.Error()
@@ -15,8 +15,6 @@ import (
 func TestCheckPodToPodNetworkPolicy(t *testing.T) {
 	// YAML 파일 "pod_to_pod_policy.yaml"에서 테스트 케이스 로드
 	testCases := testutils.LoadTestCases(t, "pod_to_pod_policy.yaml")
-	// 예시로 eksCluster 이름을 "test-cluster"로 사용합니다.
-	eksClusterName := "test-cluster"
 	t.Logf("Loaded %d test cases", len(testCases))
 
 	for _, tc := range testCases {
@@ -137,8 +135,8 @@ func TestCheckPodToPodNetworkPolicy(t *testing.T) {
 				}
 			}
 
-			// CheckPodToPodNetworkPolicy 함수 실행 (eksCluster 이름을 인자로 전달)
-			result := security.CheckPodToPodNetworkPolicy(client, eksClusterName)
+			// CheckPodToPodNetworkPolicy 함수 실행 (증적은 테스트용 임시 디렉터리에 저장)
+			result := security.CheckPodToPodNetworkPolicy(context.Background(), client, t.TempDir())
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expectPass, result.Passed)
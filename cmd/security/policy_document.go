@@ -0,0 +1,105 @@
+package security
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PolicyDocument IAM/KMS 정책 문서 (IAM API가 돌려주는 URL 인코딩 문서도 처리)
+type PolicyDocument struct {
+	Version   string
+	Statement []PolicyStatement
+}
+
+// PolicyStatement 정책 문장 (Action, Resource 등은 문자열 하나 또는 목록)
+type PolicyStatement struct {
+	Sid          string
+	Effect       string
+	Principal    PolicyPrincipal
+	NotPrincipal PolicyPrincipal
+	Action       StringList
+	NotAction    StringList
+	Resource     StringList
+	NotResource  StringList
+	// Condition 연산자 → 조건 키 → 값
+	Condition map[string]map[string]StringList
+}
+
+// StringList 문자열 하나 또는 문자열 목록으로 표현되는 정책 값
+type StringList []string
+
+func (s *StringList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*s = StringList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// PolicyPrincipal 정책 principal ("*" 또는 {"AWS": ..., "Service": ..., "Federated": ...})
+type PolicyPrincipal map[string]StringList
+
+func (p *PolicyPrincipal) UnmarshalJSON(data []byte) error {
+	var all string
+	if err := json.Unmarshal(data, &all); err == nil {
+		*p = PolicyPrincipal{"*": StringList{all}}
+		return nil
+	}
+	var principals map[string]StringList
+	if err := json.Unmarshal(data, &principals); err != nil {
+		return err
+	}
+	*p = principals
+	return nil
+}
+
+// IsWildcard 모든 principal("*" 또는 {"AWS": "*"}) 여부
+func (p PolicyPrincipal) IsWildcard() bool {
+	return slices.Contains(p["*"], "*") || slices.Contains(p["AWS"], "*")
+}
+
+// ParsePolicyDocument 정책 문서 JSON 파싱
+// Statement가 목록이 아닌 객체 하나인 문서와 URL 인코딩된 문서(IAM GetRolePolicy 등)도 처리
+func ParsePolicyDocument(document string) (*PolicyDocument, error) {
+	if !strings.HasPrefix(strings.TrimSpace(document), "{") {
+		decoded, err := url.PathUnescape(document)
+		if err != nil {
+			return nil, fmt.Errorf("정책 문서 디코딩 실패: %w", err)
+		}
+		document = decoded
+	}
+
+	var raw struct {
+		Version   string
+		Statement json.RawMessage
+	}
+	if err := json.Unmarshal([]byte(document), &raw); err != nil {
+		return nil, fmt.Errorf("정책 문서 파싱 실패: %w", err)
+	}
+
+	doc := &PolicyDocument{Version: raw.Version}
+	if len(raw.Statement) == 0 {
+		return doc, nil
+	}
+	if strings.HasPrefix(strings.TrimSpace(string(raw.Statement)), "{") {
+		var statement PolicyStatement
+		if err := json.Unmarshal(raw.Statement, &statement); err != nil {
+			return nil, fmt.Errorf("정책 문장 파싱 실패: %w", err)
+		}
+		doc.Statement = []PolicyStatement{statement}
+		return doc, nil
+	}
+	if err := json.Unmarshal(raw.Statement, &doc.Statement); err != nil {
+		return nil, fmt.Errorf("정책 문장 파싱 실패: %w", err)
+	}
+	return doc, nil
+}
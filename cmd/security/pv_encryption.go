@@ -5,13 +5,14 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // CheckPVEcryption - Persistent Volume (PV)의 암호화 상태를 확인
-func CheckPVEcryption(client kubernetes.Interface) common.CheckResult {
+func CheckPVEcryption(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-010] PV 암호화",
 		Manual:     false,
@@ -20,7 +21,7 @@ func CheckPVEcryption(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-010",
 	}
 
-	pvs, err := client.CoreV1().PersistentVolumes().List(context.TODO(), metav1.ListOptions{})
+	pvs, err := kube.ListAll(ctx, client.CoreV1().PersistentVolumes().List, metav1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
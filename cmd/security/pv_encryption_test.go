@@ -80,7 +80,7 @@ func TestCheckPVEncryption(t *testing.T) {
 				}, v1.CreateOptions{})
 			}
 
-			result := security.CheckPVEcryption(client)
+			result := security.CheckPVEcryption(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected %v, got %v", testName, !expectPass, result.Passed)
@@ -0,0 +1,274 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// elevatedClusterRoles default ServiceAccount에 바인딩되면 과도한 권한으로 보는 기본 ClusterRole
+var elevatedClusterRoles = []string{"cluster-admin", "admin", "edit"}
+
+// eksManagedBindings EKS가 생성하고 관리하는 바인딩 이름 (ClusterRoleBinding 또는 kube-system RoleBinding)
+var eksManagedBindings = []string{
+	"eks:addon-manager",
+	"eks:authenticator",
+	"eks:az-poller",
+	"eks:certificate-controller",
+	"eks:cloud-controller-manager",
+	"eks:cluster-event-watcher",
+	"eks:coredns-autoscaler",
+	"eks:fargate-manager",
+	"eks:fargate-scheduler",
+	"eks:k8s-metrics",
+	"eks:kube-proxy",
+	"eks:kube-proxy-fargate",
+	"eks:kube-proxy-windows",
+	"eks:network-policy-controller",
+	"eks:node-bootstrapper",
+	"eks:node-manager",
+	"eks:nodewatcher",
+	"eks:pod-identity-mutating-webhook",
+	"eks:podsecuritypolicy:authenticated",
+	"eks:service-operations",
+	"eks:tagging-controller",
+	"eks:vpc-resource-controller",
+	"eks-vpc-resource-controller-rolebinding",
+}
+
+// rbacBinding RoleBinding/ClusterRoleBinding 공통 정보
+type rbacBinding struct {
+	path     string // ClusterRoleBinding/<이름> 또는 RoleBinding/<네임스페이스>/<이름>
+	cluster  bool   // ClusterRoleBinding 여부 (클러스터 전체 범위)
+	roleRef  rbacv1.RoleRef
+	subjects []rbacv1.Subject
+	meta     v1.ObjectMeta
+}
+
+// CheckRBACRisk RoleBinding/ClusterRoleBinding을 Role/ClusterRole 규칙까지 따라가며 위험한 권한 부여 점검
+// - cluster-admin 수준 권한, 와일드카드 동작/리소스
+// - escalate, bind, impersonate 동작과 pods/exec create 권한
+// - ClusterRoleBinding으로 모든 네임스페이스의 Secret get/list 권한
+// - system:anonymous, system:unauthenticated 바인딩
+// - 권한이 높은 default ServiceAccount
+// Kubernetes/EKS가 관리하는 기본 바인딩(kubernetes.io/bootstrapping=rbac-defaults 라벨, 알려진 EKS 관리 바인딩)은
+// 익명/미인증 사용자 바인딩만 점검 (이름이 system:, eks:로 시작해도 라벨이나 알려진 이름이 아니면 모두 점검)
+// 결과 항목은 "주체 → 바인딩 → 역할 [규칙]: 문제" 형식
+func CheckRBACRisk(ctx context.Context, client kubernetes.Interface) common.CheckResult {
+	result := common.CheckResult{
+		CheckName: "[SEC-015] Kubernetes RBAC 최소 권한 부여",
+		Manual:    false,
+		Passed:    false,
+		Runbook:   "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-015",
+	}
+
+	findings, err := rbacRiskFindings(ctx, client)
+	if err != nil {
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+
+	if len(findings) > 0 {
+		result.FailureMsg = fmt.Sprintf("위험한 RBAC 권한 부여 %d건이 발견되었습니다.", len(findings))
+		result.Resources = findings
+		return result
+	}
+
+	result.Passed = true
+	return result
+}
+
+func rbacRiskFindings(ctx context.Context, client kubernetes.Interface) ([]string, error) {
+	clusterRoles, err := kube.ListAll(ctx, client.RbacV1().ClusterRoles().List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ClusterRole 목록 조회 실패: %w", err)
+	}
+	roles, err := kube.ListAll(ctx, client.RbacV1().Roles("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Role 목록 조회 실패: %w", err)
+	}
+	clusterRoleBindings, err := kube.ListAll(ctx, client.RbacV1().ClusterRoleBindings().List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("ClusterRoleBinding 목록 조회 실패: %w", err)
+	}
+	roleBindings, err := kube.ListAll(ctx, client.RbacV1().RoleBindings("").List, v1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("RoleBinding 목록 조회 실패: %w", err)
+	}
+
+	clusterRoleMap := map[string]rbacv1.ClusterRole{}
+	for _, role := range clusterRoles.Items {
+		clusterRoleMap[role.Name] = role
+	}
+	roleMap := map[string]rbacv1.Role{}
+	for _, role := range roles.Items {
+		roleMap[role.Namespace+"/"+role.Name] = role
+	}
+
+	var bindings []rbacBinding
+	for _, b := range clusterRoleBindings.Items {
+		bindings = append(bindings, rbacBinding{path: "ClusterRoleBinding/" + b.Name, cluster: true, roleRef: b.RoleRef, subjects: b.Subjects, meta: b.ObjectMeta})
+	}
+	for _, b := range roleBindings.Items {
+		bindings = append(bindings, rbacBinding{path: "RoleBinding/" + b.Namespace + "/" + b.Name, roleRef: b.RoleRef, subjects: b.Subjects, meta: b.ObjectMeta})
+	}
+
+	var findings []string
+	seen := map[string]bool{}
+	add := func(finding string) {
+		if !seen[finding] {
+			seen[finding] = true
+			findings = append(findings, finding)
+		}
+	}
+
+	for _, b := range bindings {
+		defaultBinding := isDefaultBinding(b.meta)
+
+		// 바인딩이 가리키는 역할과 규칙 (존재하지 않는 역할은 권한이 없으므로 제외)
+		var rolePath string
+		var rules []rbacv1.PolicyRule
+		adminRole := false
+		switch b.roleRef.Kind {
+		case "ClusterRole":
+			role, ok := clusterRoleMap[b.roleRef.Name]
+			if !ok {
+				continue
+			}
+			rolePath = "ClusterRole/" + role.Name
+			rules = role.Rules
+			adminRole = role.Name == "cluster-admin" || isClusterAdminRole(role)
+		case "Role":
+			role, ok := roleMap[b.meta.Namespace+"/"+b.roleRef.Name]
+			if !ok {
+				continue
+			}
+			rolePath = "Role/" + role.Namespace + "/" + role.Name
+			rules = role.Rules
+		default:
+			continue
+		}
+
+		// 역할 규칙별 위험 항목
+		type ruleRisk struct {
+			rule    string
+			problem string
+		}
+		var risks []ruleRisk
+		switch {
+		case defaultBinding:
+			// 기본 바인딩은 익명/미인증 사용자 바인딩만 점검
+		case adminRole && b.cluster:
+			risks = append(risks, ruleRisk{problem: "cluster-admin 권한 (클러스터 전체 모든 리소스에 대한 모든 동작)"})
+		default:
+			for _, rule := range rules {
+				for _, problem := range ruleProblems(rule, b.cluster) {
+					risks = append(risks, ruleRisk{rule: " " + formatRule(rule), problem: problem})
+				}
+			}
+		}
+
+		for _, subject := range b.subjects {
+			subjectPath := formatSubject(subject, b.meta.Namespace)
+			prefix := subjectPath + " → " + b.path + " → " + rolePath
+
+			if isAnonymousSubject(subject) && !publicInfoOnly(rules) {
+				add(prefix + ": 익명/미인증 사용자(" + subject.Name + ")에게 권한 부여")
+			}
+			for _, risk := range risks {
+				add(prefix + risk.rule + ": " + risk.problem)
+			}
+			if !defaultBinding && subject.Kind == rbacv1.ServiceAccountKind && subject.Name == "default" {
+				if len(risks) > 0 || (b.roleRef.Kind == "ClusterRole" && slices.Contains(elevatedClusterRoles, b.roleRef.Name)) {
+					add(prefix + ": default ServiceAccount에 높은 권한 부여 (네임스페이스의 모든 Pod가 권한을 상속)")
+				}
+			}
+		}
+	}
+	return findings, nil
+}
+
+// ruleProblems 규칙 하나의 위험 항목 (cluster가 true이면 클러스터 전체 범위로 부여된 규칙)
+func ruleProblems(rule rbacv1.PolicyRule, cluster bool) []string {
+	var problems []string
+	coreGroup := slices.Contains(rule.APIGroups, "") || slices.Contains(rule.APIGroups, "*")
+
+	if slices.Contains(rule.Verbs, "*") || slices.Contains(rule.Resources, "*") {
+		problems = append(problems, "와일드카드 동작/리소스 권한")
+	}
+	for _, verb := range []string{"escalate", "bind", "impersonate"} {
+		if slices.Contains(rule.Verbs, verb) {
+			problems = append(problems, verb+" 권한 (권한 상승 가능)")
+		}
+	}
+	if coreGroup && (slices.Contains(rule.Verbs, "create") || slices.Contains(rule.Verbs, "*")) &&
+		(slices.Contains(rule.Resources, "pods/exec") || slices.Contains(rule.Resources, "pods/*") || slices.Contains(rule.Resources, "*")) {
+		problems = append(problems, "pods/exec create 권한 (실행 중인 컨테이너에서 명령 실행 가능)")
+	}
+	if cluster && coreGroup && len(rule.ResourceNames) == 0 &&
+		(slices.Contains(rule.Resources, "secrets") || slices.Contains(rule.Resources, "*")) &&
+		(slices.Contains(rule.Verbs, "get") || slices.Contains(rule.Verbs, "list") || slices.Contains(rule.Verbs, "*")) {
+		problems = append(problems, "모든 네임스페이스의 Secret 조회(get/list) 권한")
+	}
+	return problems
+}
+
+// isDefaultBinding Kubernetes/EKS가 관리하는 기본 바인딩 여부
+// kubernetes.io/bootstrapping=rbac-defaults 라벨이 있거나, ClusterRoleBinding 또는 kube-system RoleBinding 중 알려진 EKS 관리 이름
+func isDefaultBinding(meta v1.ObjectMeta) bool {
+	if meta.Labels["kubernetes.io/bootstrapping"] == "rbac-defaults" {
+		return true
+	}
+	return (meta.Namespace == "" || meta.Namespace == "kube-system") && slices.Contains(eksManagedBindings, meta.Name)
+}
+
+// publicInfoOnly 규칙이 모두 nonResourceURLs get 권한인지 (system:public-info-viewer처럼 /healthz, /version 등만 공개)
+func publicInfoOnly(rules []rbacv1.PolicyRule) bool {
+	if len(rules) == 0 {
+		return false
+	}
+	for _, rule := range rules {
+		if len(rule.NonResourceURLs) == 0 || len(rule.Resources) > 0 || slices.Contains(rule.NonResourceURLs, "*") {
+			return false
+		}
+		if slices.ContainsFunc(rule.Verbs, func(verb string) bool { return verb != "get" }) {
+			return false
+		}
+	}
+	return true
+}
+
+// isAnonymousSubject 익명 사용자 또는 미인증 그룹 여부
+func isAnonymousSubject(subject rbacv1.Subject) bool {
+	return (subject.Kind == rbacv1.UserKind && subject.Name == "system:anonymous") ||
+		(subject.Kind == rbacv1.GroupKind && subject.Name == "system:unauthenticated")
+}
+
+// formatSubject 주체 표시 (ServiceAccount는 네임스페이스 포함, 생략 시 바인딩의 네임스페이스)
+func formatSubject(subject rbacv1.Subject, bindingNamespace string) string {
+	if subject.Kind == rbacv1.ServiceAccountKind {
+		namespace := subject.Namespace
+		if namespace == "" {
+			namespace = bindingNamespace
+		}
+		return "ServiceAccount/" + namespace + "/" + subject.Name
+	}
+	return subject.Kind + "/" + subject.Name
+}
+
+// formatRule 규칙 표시 (예: [verbs=get,list resources=secrets])
+func formatRule(rule rbacv1.PolicyRule) string {
+	resources := rule.Resources
+	if len(resources) == 0 {
+		resources = rule.NonResourceURLs
+	}
+	return "[verbs=" + strings.Join(rule.Verbs, ",") + " resources=" + strings.Join(resources, ",") + "]"
+}
@@ -0,0 +1,60 @@
+package security_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestCheckRBACRisk(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "rbac_risk.yaml")
+
+	for _, tc := range testCases {
+		name := tc["name"].(string)
+		expectPass := tc["expect_pass"].(bool)
+
+		t.Run(name, func(t *testing.T) {
+			var objects []runtime.Object
+			for _, raw := range tc["objects"].([]interface{}) {
+				data, err := yaml.Marshal(raw)
+				if err != nil {
+					t.Fatalf("객체 변환 실패: %v", err)
+				}
+				obj, _, err := scheme.Codecs.UniversalDeserializer().Decode(data, nil, nil)
+				if err != nil {
+					t.Fatalf("객체 디코딩 실패: %v", err)
+				}
+				objects = append(objects, obj)
+			}
+			client := fake.NewSimpleClientset(objects...)
+
+			result := security.CheckRBACRisk(context.Background(), client)
+
+			if result.Passed != expectPass {
+				t.Errorf("Test '%s' failed: expected %v, got %v (%v)", name, expectPass, result.Passed, result.Resources)
+			}
+			for _, expect := range testutils.ToStrings(tc["expect_findings"]) {
+				found := false
+				for _, finding := range result.Resources {
+					if strings.HasPrefix(finding, expect) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Test '%s' failed: expected finding starting with %q, got %v", name, expect, result.Resources)
+				}
+			}
+			if count, ok := tc["expect_count"].(int); ok && len(result.Resources) != count {
+				t.Errorf("Test '%s' failed: expected %d findings, got %d: %v", name, count, len(result.Resources), result.Resources)
+			}
+		})
+	}
+}
@@ -5,13 +5,15 @@ import (
 	"fmt"
 
 	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
 
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 // ReadnonlyFilesystemCheck checks whether containers use readOnlyRootFilesystem.
-func ReadnonlyFilesystemCheck(client kubernetes.Interface) common.CheckResult {
+func ReadnonlyFilesystemCheck(ctx context.Context, client kubernetes.Interface) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-014] 읽기 전용 파일시스템 사용",
 		Manual:     false,
@@ -20,7 +22,7 @@ func ReadnonlyFilesystemCheck(client kubernetes.Interface) common.CheckResult {
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-014",
 	}
 
-	pods, err := client.CoreV1().Pods("").List(context.TODO(), v1.ListOptions{})
+	pods, err := kube.ListAll(ctx, client.CoreV1().Pods("").List, v1.ListOptions{})
 	if err != nil {
 		result.Passed = false
 		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
@@ -37,7 +39,7 @@ func ReadnonlyFilesystemCheck(client kubernetes.Interface) common.CheckResult {
 		nodeName := pod.Spec.NodeName
 		nodeOS, ok := nodeOSCache[nodeName]
 		if !ok {
-			node, err := client.CoreV1().Nodes().Get(context.TODO(), nodeName, v1.GetOptions{})
+			node, err := client.CoreV1().Nodes().Get(ctx, nodeName, v1.GetOptions{})
 			if err != nil {
 				nodeOS = "unknown"
 			} else if osLabel, exists := node.Labels["kubernetes.io/os"]; exists {
@@ -48,19 +50,28 @@ func ReadnonlyFilesystemCheck(client kubernetes.Interface) common.CheckResult {
 			nodeOSCache[nodeName] = nodeOS
 		}
 
-		for _, container := range pod.Spec.Containers {
-			if nodeOS == "windows" {
-				continue
-			}
+		if nodeOS == "windows" {
+			continue
+		}
 
-			sc := container.SecurityContext
-			if sc == nil || sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
-				result.Passed = false
-				result.Resources = append(result.Resources,
-					fmt.Sprintf("Namespace: %s | Pod: %s | Container: %s", pod.Namespace, pod.Name, container.Name))
-			}
+		for _, violation := range ReadonlyFilesystemViolations(pod.Spec) {
+			result.Passed = false
+			result.Resources = append(result.Resources,
+				fmt.Sprintf("Namespace: %s | Pod: %s | %s", pod.Namespace, pod.Name, violation))
 		}
 	}
 
 	return result
 }
+
+// ReadonlyFilesystemViolations PodSpec에서 readOnlyRootFilesystem=true가 아닌 컨테이너를 찾아 반환
+func ReadonlyFilesystemViolations(spec corev1.PodSpec) []string {
+	var violations []string
+	for _, container := range spec.Containers {
+		sc := container.SecurityContext
+		if sc == nil || sc.ReadOnlyRootFilesystem == nil || !*sc.ReadOnlyRootFilesystem {
+			violations = append(violations, fmt.Sprintf("Container: %s", container.Name))
+		}
+	}
+	return violations
+}
@@ -86,7 +86,7 @@ func TestReadnonlyFilesystemCheck_YAML(t *testing.T) {
 			}
 
 			// 테스트 실행 및 결과 검증
-			result := security.ReadnonlyFilesystemCheck(client)
+			result := security.ReadnonlyFilesystemCheck(context.Background(), client)
 
 			if result.Passed != expectPass {
 				t.Errorf("Test '%s' failed: expected pass = %v, got %v", testName, expectPass, result)
@@ -6,46 +6,132 @@ import (
 
 	"eks-checklist/cmd/common"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
 )
 
-// Secret 객체의 암호화 여부를 확인하는 함수
-func CheckSecretEncryption(client kubernetes.Interface) common.CheckResult {
+// KMSKeyAPI SEC-011 점검에 사용하는 KMS API
+type KMSKeyAPI interface {
+	DescribeKey(ctx context.Context, params *kms.DescribeKeyInput, optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error)
+	GetKeyRotationStatus(ctx context.Context, params *kms.GetKeyRotationStatusInput, optFns ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error)
+	GetKeyPolicy(ctx context.Context, params *kms.GetKeyPolicyInput, optFns ...func(*kms.Options)) (*kms.GetKeyPolicyOutput, error)
+}
+
+// CheckSecretEncryption 클러스터의 KMS 기반 Secret 봉투 암호화(envelope encryption) 설정과 KMS 키 상태 확인
+func CheckSecretEncryption(ctx context.Context, cfg aws.Config, eksCluster EksCluster) common.CheckResult {
+	return EvaluateSecretEncryption(ctx, kms.NewFromConfig(cfg), eksCluster)
+}
+
+// EvaluateSecretEncryption 클러스터 EncryptionConfig의 secrets 암호화 KMS 키 확인
+// - KMS 키가 활성화(Enabled) 상태인지
+// - 자동 키 교체가 활성화되어 있는지
+// - 키 정책이 조건 없이 모든 principal("*")에게 허용하지 않는지
+func EvaluateSecretEncryption(ctx context.Context, api KMSKeyAPI, eksCluster EksCluster) common.CheckResult {
+	result := CheckClusterSecretEncryption(eksCluster)
+	if !result.Passed {
+		return result
+	}
+
+	keyArn, _ := SecretsEncryptionKeyArn(eksCluster)
+	if keyArn == "" {
+		return result
+	}
+
+	var findings []string
+
+	// 1. 키 상태
+	key, err := api.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyArn)})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : KMS 키 조회 실패: " + err.Error()
+		return result
+	}
+	if key.KeyMetadata != nil && key.KeyMetadata.KeyState != kmstypes.KeyStateEnabled {
+		findings = append(findings, fmt.Sprintf("KMS 키 상태: %s (Secret을 복호화하지 못해 클러스터가 동작하지 않을 수 있음)", key.KeyMetadata.KeyState))
+	}
+
+	// 2. 자동 키 교체
+	rotation, err := api.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: aws.String(keyArn)})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : KMS 키 교체 상태 조회 실패: " + err.Error()
+		return result
+	}
+	if !rotation.KeyRotationEnabled {
+		findings = append(findings, "KMS 키 자동 교체 비활성화")
+	}
+
+	// 3. 키 정책
+	policy, err := api.GetKeyPolicy(ctx, &kms.GetKeyPolicyInput{KeyId: aws.String(keyArn), PolicyName: aws.String("default")})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : KMS 키 정책 조회 실패: " + err.Error()
+		return result
+	}
+	document, err := ParsePolicyDocument(aws.ToString(policy.Policy))
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	for i, statement := range document.Statement {
+		if statement.Effect == "Allow" && statement.Principal.IsWildcard() && len(statement.Condition) == 0 {
+			findings = append(findings, "KMS 키 정책 문장 "+statementLabel(statement, i)+": 조건 없이 모든 principal(*)에게 허용")
+		}
+	}
+
+	if len(findings) > 0 {
+		result.Passed = false
+		result.FailureMsg = "Secret 암호화에 사용하는 KMS 키 설정에 문제가 있습니다."
+		result.Resources = append(result.Resources, findings...)
+	}
+	return result
+}
+
+// CheckClusterSecretEncryption 클러스터 EncryptionConfig에 KMS 기반 Secret 암호화가 설정되어 있는지 확인
+func CheckClusterSecretEncryption(eksCluster EksCluster) common.CheckResult {
 	result := common.CheckResult{
 		CheckName:  "[SEC-011] Secret 객체 암호화",
 		Manual:     false,
 		Passed:     true,
-		FailureMsg: "일부 Secret 객체가 암호화되지 않은 채로 저장되어 있습니다.",
+		FailureMsg: "클러스터에 KMS 기반 Secret 암호화가 설정되어 있지 않습니다.",
 		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-011",
 	}
-
-	secrets, err := client.CoreV1().Secrets("").List(context.TODO(), metav1.ListOptions{})
-	if err != nil {
+	if eksCluster.Cluster == nil {
 		result.Passed = false
-		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + errNoCluster.Error()
 		return result
 	}
 
-	// Secret 객체가 없으면 Manual 처리
-	if len(secrets.Items) == 0 {
+	keyArn, ok := SecretsEncryptionKeyArn(eksCluster)
+	if !ok {
 		result.Passed = false
-		result.Manual = true
-		result.FailureMsg = "아무런 Secret 객체가 없습니다."
+		result.Resources = append(result.Resources, "Cluster: "+aws.ToString(eksCluster.Cluster.Name))
 		return result
 	}
 
-	// 암호화 여부 판단
-	for _, secret := range secrets.Items {
-		for key, value := range secret.Data {
-			if len(value) > 0 {
-				// base64 인코딩된 데이터가 존재하는 경우 암호화 미적용으로 판단
-				result.Passed = false
-				resourceInfo := fmt.Sprintf("Namespace: %s | Secret: %s | Key: %s (base64 데이터 발견)", secret.Namespace, secret.Name, key)
-				result.Resources = append(result.Resources, resourceInfo)
+	if keyArn == "" {
+		keyArn = "미확정 (적용 시 생성되는 키)"
+	}
+	result.Resources = append(result.Resources, "KMS Key: "+keyArn)
+	return result
+}
+
+// SecretsEncryptionKeyArn secrets 리소스에 적용된 KMS 키 ARN 반환 (키 ARN이 아직 확정되지 않은 경우 빈 문자열)
+func SecretsEncryptionKeyArn(eksCluster EksCluster) (string, bool) {
+	if eksCluster.Cluster == nil {
+		return "", false
+	}
+	for _, config := range eksCluster.Cluster.EncryptionConfig {
+		for _, resource := range config.Resources {
+			if resource != "secrets" {
+				continue
+			}
+			if config.Provider != nil {
+				return aws.ToString(config.Provider.KeyArn), true
 			}
 		}
 	}
-
-	return result
+	return "", false
 }
@@ -1,58 +1,91 @@
 package security_test
 
-// import (
-// 	"context"
-// 	"encoding/base64"
-// 	"testing"
-
-// 	"eks-checklist/cmd/security"
-// 	"eks-checklist/cmd/testutils"
-
-// 	corev1 "k8s.io/api/core/v1"
-// 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-// 	"k8s.io/client-go/kubernetes/fake"
-// )
-
-// func TestCheckSecretEncryption(t *testing.T) {
-// 	testCases := testutils.LoadTestCases(t, "secret_encryption.yaml")
-
-// 	for _, tc := range testCases {
-// 		name := tc["name"].(string)
-// 		expectPass := tc["expect_pass"].(bool)
-
-// 		t.Run(name, func(t *testing.T) {
-// 			client := fake.NewSimpleClientset()
-
-// 			// 시크릿 생성
-// 			if secrets, ok := tc["secrets"].([]interface{}); ok {
-// 				for _, s := range secrets {
-// 					secretMap := s.(map[string]interface{})
-// 					secretData := map[string][]byte{}
-
-// 					if rawData, exists := secretMap["data"].(map[string]interface{}); exists {
-// 						for key, value := range rawData {
-// 							decoded, _ := base64.StdEncoding.DecodeString(value.(string))
-// 							secretData[key] = decoded
-// 						}
-// 					}
-
-// 					secret := &corev1.Secret{
-// 						ObjectMeta: metav1.ObjectMeta{
-// 							Name:      secretMap["name"].(string),
-// 							Namespace: "default",
-// 						},
-// 						Data: secretData,
-// 					}
-
-// 					client.CoreV1().Secrets("default").Create(context.TODO(), secret, metav1.CreateOptions{})
-// 				}
-// 			}
-
-// 			result := security.CheckSecretEncryption(client)
-
-// 			if result.Passed != expectPass {
-// 				t.Errorf("Test '%s' failed: expected %v, got %v", name, !expectPass, result.Passed)
-// 			}
-// 		})
-// 	}
-// }
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// fakeKMSKeyAPI 키 상태, 자동 교체 여부, 키 정책을 돌려주는 KMS API
+type fakeKMSKeyAPI struct {
+	keyState    kmstypes.KeyState
+	rotation    bool
+	policy      string
+	describeErr error
+}
+
+func (f fakeKMSKeyAPI) DescribeKey(ctx context.Context, input *kms.DescribeKeyInput, optFns ...func(*kms.Options)) (*kms.DescribeKeyOutput, error) {
+	if f.describeErr != nil {
+		return nil, f.describeErr
+	}
+	return &kms.DescribeKeyOutput{KeyMetadata: &kmstypes.KeyMetadata{KeyId: input.KeyId, KeyState: f.keyState}}, nil
+}
+
+func (f fakeKMSKeyAPI) GetKeyRotationStatus(ctx context.Context, input *kms.GetKeyRotationStatusInput, optFns ...func(*kms.Options)) (*kms.GetKeyRotationStatusOutput, error) {
+	return &kms.GetKeyRotationStatusOutput{KeyRotationEnabled: f.rotation}, nil
+}
+
+func (f fakeKMSKeyAPI) GetKeyPolicy(ctx context.Context, input *kms.GetKeyPolicyInput, optFns ...func(*kms.Options)) (*kms.GetKeyPolicyOutput, error) {
+	return &kms.GetKeyPolicyOutput{Policy: aws.String(f.policy)}, nil
+}
+
+func TestCheckSecretEncryption(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "secret_encryption.yaml")
+
+	for _, tc := range testCases {
+		name := tc["name"].(string)
+		expectPass := tc["expect_pass"].(bool)
+
+		t.Run(name, func(t *testing.T) {
+			cluster := &types.Cluster{Name: aws.String("test-cluster")}
+			if tc["no_cluster"] == true {
+				cluster = nil
+			} else if tc["encrypted"].(bool) {
+				cluster.EncryptionConfig = []types.EncryptionConfig{{
+					Resources: []string{"secrets"},
+					Provider:  &types.Provider{KeyArn: aws.String("arn:aws:kms:ap-northeast-2:123456789012:key/test")},
+				}}
+			}
+
+			api := fakeKMSKeyAPI{rotation: tc["rotation"] == true}
+			if state, ok := tc["key_state"].(string); ok {
+				api.keyState = kmstypes.KeyState(state)
+			}
+			if policy, ok := tc["policy"].(string); ok {
+				api.policy = policy
+			}
+			if msg, ok := tc["describe_error"].(string); ok {
+				api.describeErr = errors.New(msg)
+			}
+
+			result := security.EvaluateSecretEncryption(context.Background(), api, security.EksCluster{Cluster: cluster})
+
+			if result.Passed != expectPass {
+				t.Errorf("expected pass=%v, got %v (failure: %s, resources: %v)", expectPass, result.Passed, result.FailureMsg, result.Resources)
+			}
+			for _, expected := range testutils.ToStrings(tc["expect_resources"]) {
+				found := false
+				for _, resource := range result.Resources {
+					if resource == expected {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("expected resource %q, got %v", expected, result.Resources)
+				}
+			}
+			if contains, ok := tc["expect_failure_contains"].(string); ok && !strings.Contains(result.FailureMsg, contains) {
+				t.Errorf("expected failure message to contain %q, got %q", contains, result.FailureMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+// secretsStoreCSIDriver Secrets Store CSI Driver 이름 (AWS Secrets Manager, Parameter Store, Vault 등 외부 저장소 마운트)
+const secretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+
+// externalSecretOwners 외부 저장소 또는 암호화된 매니페스트에서 Secret을 만드는 컨트롤러 (Secret 소유자 Kind → 도구)
+var externalSecretOwners = map[string]string{
+	"ExternalSecret": "External Secrets Operator",
+	"SealedSecret":   "Sealed Secrets",
+}
+
+// CheckSecretExposure 워크로드가 Secret을 환경 변수로 노출하는지와 외부 시크릿 저장소 사용 여부 확인
+// - env.valueFrom.secretKeyRef, envFrom.secretRef로 Secret을 주입하는 컨테이너 (프로세스 목록, 크래시 덤프, 로그로 노출되기 쉬움)
+// - Secrets Store CSI Driver 볼륨, Vault Agent Injector 어노테이션, External Secrets Operator/Sealed Secrets가 만든 Secret
+// 환경 변수로 Secret을 주입하는 워크로드가 있으면 실패, 외부 시크릿 저장소 사용 현황은 함께 표시 (kube-system 네임스페이스는 검사 제외)
+// Secret은 metadataClient로 메타데이터만 조회해 Secret 값을 메모리에 읽지 않음
+func CheckSecretExposure(ctx context.Context, client kubernetes.Interface, metadataClient metadata.Interface) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-017] Secret 노출 최소화(환경 변수, 외부 시크릿 저장소)",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "일부 워크로드가 Secret을 환경 변수로 주입하고 있습니다. 볼륨 마운트 또는 외부 시크릿 저장소 사용을 권장합니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-017",
+	}
+
+	workloads, err := listPodWorkloads(ctx, client)
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+
+	var stores []string
+	for _, w := range workloads {
+		if w.namespace == "kube-system" {
+			continue // kube-system 네임스페이스는 검사 제외
+		}
+		prefix := fmt.Sprintf("Namespace: %s | %s: %s", w.namespace, w.kind, w.name)
+
+		spec := w.template.Spec
+		containers := append(slices.Clone(spec.InitContainers), spec.Containers...)
+		for _, c := range containers {
+			for _, env := range c.Env {
+				if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+					ref := env.ValueFrom.SecretKeyRef
+					result.Passed = false
+					result.Resources = append(result.Resources,
+						fmt.Sprintf("%s | Container: %s | 환경 변수 %s ← Secret %s (키: %s)", prefix, c.Name, env.Name, ref.Name, ref.Key))
+				}
+			}
+			for _, envFrom := range c.EnvFrom {
+				if envFrom.SecretRef != nil {
+					result.Passed = false
+					result.Resources = append(result.Resources,
+						fmt.Sprintf("%s | Container: %s | envFrom ← Secret %s (모든 키)", prefix, c.Name, envFrom.SecretRef.Name))
+				}
+			}
+		}
+
+		for _, volume := range spec.Volumes {
+			if volume.CSI != nil && volume.CSI.Driver == secretsStoreCSIDriver {
+				stores = append(stores, fmt.Sprintf("외부 시크릿 저장소: %s | Secrets Store CSI Driver (SecretProviderClass: %s)", prefix, volume.CSI.VolumeAttributes["secretProviderClass"]))
+			}
+		}
+		if w.template.Annotations["vault.hashicorp.com/agent-inject"] == "true" {
+			stores = append(stores, "외부 시크릿 저장소: "+prefix+" | Vault Agent Injector")
+		}
+	}
+
+	secrets, err := kube.ListAll(ctx, metadataClient.Resource(corev1.SchemeGroupVersion.WithResource("secrets")).List, v1.ListOptions{})
+	if err != nil {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	for _, secret := range secrets.Items {
+		if secret.Namespace == "kube-system" {
+			continue
+		}
+		owner := v1.GetControllerOf(&secret)
+		if owner == nil {
+			continue
+		}
+		if tool, ok := externalSecretOwners[owner.Kind]; ok {
+			stores = append(stores, fmt.Sprintf("외부 시크릿 저장소: Namespace: %s | Secret: %s ← %s %s (%s)", secret.Namespace, secret.Name, owner.Kind, owner.Name, tool))
+		}
+	}
+
+	if len(stores) == 0 {
+		stores = append(stores, "외부 시크릿 저장소 사용 내역 없음 (Secrets Store CSI Driver, External Secrets Operator, Sealed Secrets, Vault Agent Injector)")
+	}
+	result.Resources = append(result.Resources, stores...)
+	return result
+}
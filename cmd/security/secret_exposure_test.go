@@ -0,0 +1,31 @@
+package security_test
+
+import (
+	"context"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCheckSecretExposure(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "secret_exposure.yaml")
+
+	for _, tc := range testCases {
+		t.Run(tc["name"].(string), func(t *testing.T) {
+			set := testutils.LoadManifests(t, tc["objects"])
+			client := set.Clientset()
+			result := security.CheckSecretExposure(context.Background(), client, set.MetadataClient())
+			testutils.AssertCheckResult(t, tc, tc["expect_pass"].(bool), result)
+
+			// Secret은 메타데이터 클라이언트로만 조회해 값을 읽지 않아야 함
+			for _, action := range client.(*fake.Clientset).Actions() {
+				if action.GetResource().Resource == "secrets" {
+					t.Errorf("Secret을 typed 클라이언트로 조회함: %s", action.GetVerb())
+				}
+			}
+		})
+	}
+}
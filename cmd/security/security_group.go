@@ -0,0 +1,248 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/kube"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// nodePortRange Kubernetes NodePort 서비스 기본 포트 범위
+var nodePortRange = [2]int32{30000, 32767}
+
+// SecurityGroupAPI SEC-019 점검에 사용하는 EC2 API
+type SecurityGroupAPI interface {
+	IMDSAPI
+	ec2.DescribeSecurityGroupsAPIClient
+}
+
+func CheckSecurityGroupExposure(ctx context.Context, client kubernetes.Interface, cfg aws.Config, eksCluster EksCluster, restrictEgress bool) common.CheckResult {
+	return EvaluateSecurityGroupExposure(ctx, client, ec2.NewFromConfig(cfg), eks.NewFromConfig(cfg), eksCluster, restrictEgress)
+}
+
+// EvaluateSecurityGroupExposure 클러스터와 노드 보안 그룹이 인터넷(0.0.0.0/0, ::/0)에 열려 있는지 확인
+// - 클러스터 보안 그룹, 추가 컨트롤 플레인 보안 그룹(ResourcesVpcConfig), 노드 EC2 인스턴스와 관리형 노드 그룹 시작 템플릿의 보안 그룹
+// - 인터넷에 열린 인바운드 규칙 (SSH 22, kubelet 10250, NodePort 범위는 함께 표시)
+// - SSH 키로 원격 접속(RemoteAccess)을 허용한 관리형 노드 그룹
+// 인터넷으로 열린 아웃바운드 규칙은 restrictEgress가 true일 때만 실패로 판정하고, 아니면 참고로 표시
+func EvaluateSecurityGroupExposure(ctx context.Context, client kubernetes.Interface, ec2API SecurityGroupAPI, eksAPI NodegroupAPI, eksCluster EksCluster, restrictEgress bool) common.CheckResult {
+	result := common.CheckResult{
+		CheckName:  "[SEC-019] 보안 그룹 노출 최소화(클러스터, 노드 보안 그룹)",
+		Manual:     false,
+		Passed:     true,
+		FailureMsg: "일부 클러스터 또는 노드 보안 그룹이 인터넷(0.0.0.0/0, ::/0)에 열려 있거나 노드 그룹에 SSH 원격 접속이 허용되어 있습니다.",
+		Runbook:    "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-019",
+	}
+	fail := func(err error) common.CheckResult {
+		result.Passed = false
+		result.FailureMsg = result.CheckName + " 검사 실패 : " + err.Error()
+		return result
+	}
+	if eksCluster.Cluster == nil {
+		return fail(errNoCluster)
+	}
+
+	// 보안 그룹 ID → 사용처 (조회 순서 유지)
+	var groupIDs []string
+	usages := map[string][]string{}
+	addUsage := func(groupID, usage string) {
+		if groupID == "" {
+			return
+		}
+		if _, ok := usages[groupID]; !ok {
+			groupIDs = append(groupIDs, groupID)
+		}
+		if !slices.Contains(usages[groupID], usage) {
+			usages[groupID] = append(usages[groupID], usage)
+		}
+	}
+
+	// 1. 클러스터 보안 그룹과 추가 컨트롤 플레인 보안 그룹
+	if vpc := eksCluster.Cluster.ResourcesVpcConfig; vpc != nil {
+		addUsage(aws.ToString(vpc.ClusterSecurityGroupId), "클러스터 보안 그룹")
+		for _, groupID := range vpc.SecurityGroupIds {
+			addUsage(groupID, "추가 컨트롤 플레인 보안 그룹")
+		}
+	}
+
+	// 2. 노드 EC2 인스턴스 보안 그룹
+	nodes, err := kube.ListAll(ctx, client.CoreV1().Nodes().List, v1.ListOptions{})
+	if err != nil {
+		return fail(err)
+	}
+	instances, _, err := describeNodeInstances(ctx, ec2API, nodes.Items)
+	if err != nil {
+		return fail(err)
+	}
+	var nodeGroupIDs []string
+	nodesByGroup := map[string][]string{}
+	for _, instance := range instances {
+		if instance.instance == nil {
+			continue
+		}
+		for _, group := range instance.instance.SecurityGroups {
+			groupID := aws.ToString(group.GroupId)
+			if _, ok := nodesByGroup[groupID]; !ok {
+				nodeGroupIDs = append(nodeGroupIDs, groupID)
+			}
+			nodesByGroup[groupID] = append(nodesByGroup[groupID], instance.nodes...)
+		}
+	}
+	for _, groupID := range nodeGroupIDs {
+		addUsage(groupID, "Node: "+summarizeNames(nodesByGroup[groupID], 3))
+	}
+
+	// 3. 관리형 노드 그룹 시작 템플릿 보안 그룹과 SSH 원격 접속
+	nodegroups, err := listNodegroups(ctx, eksAPI, aws.ToString(eksCluster.Cluster.Name))
+	if err != nil {
+		return fail(err)
+	}
+	for _, nodegroup := range nodegroups {
+		name := aws.ToString(nodegroup.NodegroupName)
+		versions, err := nodegroupLaunchTemplate(ctx, ec2API, nodegroup)
+		if err != nil {
+			return fail(err)
+		}
+		for _, version := range versions {
+			if version.LaunchTemplateData == nil {
+				continue
+			}
+			data := version.LaunchTemplateData
+			groups := slices.Clone(data.SecurityGroupIds)
+			for _, eni := range data.NetworkInterfaces {
+				groups = append(groups, eni.Groups...)
+			}
+			for _, groupID := range groups {
+				addUsage(groupID, "Nodegroup: "+name+" (시작 템플릿)")
+			}
+		}
+
+		if remote := nodegroup.RemoteAccess; remote != nil && aws.ToString(remote.Ec2SshKey) != "" {
+			source := "소스 보안 그룹 미지정, 모든 IP(0.0.0.0/0)에서 SSH 접근 가능"
+			if len(remote.SourceSecurityGroups) > 0 {
+				source = "소스 보안 그룹: " + strings.Join(remote.SourceSecurityGroups, ", ")
+			}
+			result.Passed = false
+			result.Resources = append(result.Resources, fmt.Sprintf("Nodegroup: %s | SSH 원격 접속 허용 (키 페어: %s, %s)", name, aws.ToString(remote.Ec2SshKey), source))
+		}
+		if nodegroup.Resources != nil {
+			addUsage(aws.ToString(nodegroup.Resources.RemoteAccessSecurityGroup), "Nodegroup: "+name+" (원격 접속)")
+		}
+	}
+
+	if len(groupIDs) == 0 {
+		return result
+	}
+
+	// 4. 보안 그룹 규칙
+	var egress []string
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(ec2API, &ec2.DescribeSecurityGroupsInput{GroupIds: groupIDs})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return fail(fmt.Errorf("보안 그룹 조회 실패: %w", err))
+		}
+		for _, group := range page.SecurityGroups {
+			groupID := aws.ToString(group.GroupId)
+			prefix := fmt.Sprintf("SecurityGroup: %s (%s)", groupID, strings.Join(usages[groupID], ", "))
+			for _, permission := range group.IpPermissions {
+				if cidrs := openCIDRs(permission); len(cidrs) > 0 {
+					result.Passed = false
+					result.Resources = append(result.Resources, fmt.Sprintf("%s | 인바운드 %s → %s", prefix, strings.Join(cidrs, ", "), describePermission(permission, true)))
+				}
+			}
+			for _, permission := range group.IpPermissionsEgress {
+				if cidrs := openCIDRs(permission); len(cidrs) > 0 {
+					egress = append(egress, fmt.Sprintf("%s | 아웃바운드 %s → %s", prefix, strings.Join(cidrs, ", "), describePermission(permission, false)))
+				}
+			}
+		}
+	}
+
+	if restrictEgress {
+		if len(egress) > 0 {
+			result.Passed = false
+		}
+		result.Resources = append(result.Resources, egress...)
+	} else {
+		for _, line := range egress {
+			result.Resources = append(result.Resources, "참고: "+line)
+		}
+	}
+	return result
+}
+
+// openCIDRs 규칙에서 인터넷 전체(0.0.0.0/0, ::/0)를 허용하는 CIDR
+func openCIDRs(permission ec2types.IpPermission) []string {
+	var cidrs []string
+	if slices.ContainsFunc(permission.IpRanges, func(r ec2types.IpRange) bool { return aws.ToString(r.CidrIp) == "0.0.0.0/0" }) {
+		cidrs = append(cidrs, "0.0.0.0/0")
+	}
+	if slices.ContainsFunc(permission.Ipv6Ranges, func(r ec2types.Ipv6Range) bool { return aws.ToString(r.CidrIpv6) == "::/0" }) {
+		cidrs = append(cidrs, "::/0")
+	}
+	return cidrs
+}
+
+// describePermission 규칙의 프로토콜과 포트 표시 (인바운드는 SSH, kubelet, NodePort 범위 포함 여부를 함께 표시)
+func describePermission(permission ec2types.IpPermission, inbound bool) string {
+	protocol := strings.ToLower(aws.ToString(permission.IpProtocol))
+	switch protocol {
+	case "-1":
+		protocol = "모든 트래픽"
+	case "6":
+		protocol = "tcp"
+	case "17":
+		protocol = "udp"
+	}
+	if protocol == "모든 트래픽" {
+		return protocol + sensitivePorts(0, 65535, inbound)
+	}
+	if protocol != "tcp" && protocol != "udp" {
+		return strings.ToUpper(protocol)
+	}
+	from, to := aws.ToInt32(permission.FromPort), aws.ToInt32(permission.ToPort)
+	ports := fmt.Sprintf("%d", from)
+	if from == -1 || (from == 0 && to == 65535) {
+		ports = "모든 포트"
+		from, to = 0, 65535
+	} else if from != to {
+		ports = fmt.Sprintf("%d-%d", from, to)
+	}
+	label := strings.ToUpper(protocol) + " " + ports
+	if protocol == "tcp" {
+		label += sensitivePorts(from, to, inbound)
+	}
+	return label
+}
+
+// sensitivePorts 포트 범위에 포함된 민감한 노드 포트 표시
+func sensitivePorts(from, to int32, inbound bool) string {
+	if !inbound {
+		return ""
+	}
+	var names []string
+	if from <= 22 && 22 <= to {
+		names = append(names, "SSH")
+	}
+	if from <= 10250 && 10250 <= to {
+		names = append(names, "kubelet API")
+	}
+	if from <= nodePortRange[1] && nodePortRange[0] <= to {
+		names = append(names, "NodePort 범위")
+	}
+	if len(names) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(names, ", ") + ")"
+}
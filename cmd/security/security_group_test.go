@@ -0,0 +1,125 @@
+package security_test
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/security"
+	"eks-checklist/cmd/testutils"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// fakeSecurityGroupAPI 인스턴스 ID, 시작 템플릿 ID → 보안 그룹 ID와 보안 그룹 ID → 규칙을 돌려주는 EC2 API
+type fakeSecurityGroupAPI struct {
+	instanceGroups       map[string][]string
+	launchTemplateGroups map[string][]string
+	rules                map[string]map[string][]string // 보안 그룹 ID → ingress/egress → "프로토콜 [포트] CIDR"
+}
+
+func (f fakeSecurityGroupAPI) DescribeInstances(ctx context.Context, input *ec2.DescribeInstancesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeInstancesOutput, error) {
+	reservation := ec2types.Reservation{}
+	for _, id := range input.InstanceIds {
+		instance := ec2types.Instance{InstanceId: aws.String(id)}
+		for _, group := range f.instanceGroups[id] {
+			instance.SecurityGroups = append(instance.SecurityGroups, ec2types.GroupIdentifier{GroupId: aws.String(group)})
+		}
+		reservation.Instances = append(reservation.Instances, instance)
+	}
+	return &ec2.DescribeInstancesOutput{Reservations: []ec2types.Reservation{reservation}}, nil
+}
+
+func (f fakeSecurityGroupAPI) DescribeLaunchTemplateVersions(ctx context.Context, input *ec2.DescribeLaunchTemplateVersionsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeLaunchTemplateVersionsOutput, error) {
+	id := aws.ToString(input.LaunchTemplateId)
+	return &ec2.DescribeLaunchTemplateVersionsOutput{LaunchTemplateVersions: []ec2types.LaunchTemplateVersion{
+		{LaunchTemplateId: aws.String(id), VersionNumber: aws.Int64(1), LaunchTemplateData: &ec2types.ResponseLaunchTemplateData{SecurityGroupIds: f.launchTemplateGroups[id]}},
+	}}, nil
+}
+
+func (f fakeSecurityGroupAPI) DescribeSecurityGroups(ctx context.Context, input *ec2.DescribeSecurityGroupsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSecurityGroupsOutput, error) {
+	output := &ec2.DescribeSecurityGroupsOutput{}
+	for _, id := range input.GroupIds {
+		group := ec2types.SecurityGroup{GroupId: aws.String(id)}
+		for _, rule := range f.rules[id]["ingress"] {
+			group.IpPermissions = append(group.IpPermissions, toIpPermission(rule))
+		}
+		for _, rule := range f.rules[id]["egress"] {
+			group.IpPermissionsEgress = append(group.IpPermissionsEgress, toIpPermission(rule))
+		}
+		output.SecurityGroups = append(output.SecurityGroups, group)
+	}
+	return output, nil
+}
+
+// toIpPermission "tcp 22 0.0.0.0/0", "tcp 30000-32767 ::/0", "-1 0.0.0.0/0" 형식의 규칙 변환
+func toIpPermission(rule string) ec2types.IpPermission {
+	fields := strings.Fields(rule)
+	permission := ec2types.IpPermission{IpProtocol: aws.String(fields[0])}
+	if len(fields) == 3 {
+		from, to, found := strings.Cut(fields[1], "-")
+		if !found {
+			to = from
+		}
+		fromPort, _ := strconv.Atoi(from)
+		toPort, _ := strconv.Atoi(to)
+		permission.FromPort, permission.ToPort = aws.Int32(int32(fromPort)), aws.Int32(int32(toPort))
+	}
+	cidr := fields[len(fields)-1]
+	if strings.Contains(cidr, ":") {
+		permission.Ipv6Ranges = []ec2types.Ipv6Range{{CidrIpv6: aws.String(cidr)}}
+	} else {
+		permission.IpRanges = []ec2types.IpRange{{CidrIp: aws.String(cidr)}}
+	}
+	return permission
+}
+
+func toStringsMap(v interface{}) map[string][]string {
+	result := map[string][]string{}
+	raw, _ := v.(map[string]interface{})
+	for key, value := range raw {
+		result[key] = testutils.ToStrings(value)
+	}
+	return result
+}
+
+func TestEvaluateSecurityGroupExposure_YAML(t *testing.T) {
+	// YAML 파일 "security_group.yaml"에서 테스트 케이스 로드
+	testCases := testutils.LoadTestCases(t, "security_group.yaml")
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		expectPass := tc["expected"].(bool)
+		restrictEgress, _ := tc["restrict_egress"].(bool)
+
+		t.Run(testName, func(t *testing.T) {
+			set := testutils.LoadManifests(t, tc["objects"])
+			ec2API := fakeSecurityGroupAPI{
+				instanceGroups:       toStringsMap(tc["instances"]),
+				launchTemplateGroups: toStringsMap(tc["launch_templates"]),
+				rules:                map[string]map[string][]string{},
+			}
+			if groups, ok := tc["security_groups"].(map[string]interface{}); ok {
+				for id, rules := range groups {
+					ec2API.rules[id] = toStringsMap(rules)
+				}
+			}
+			vpc := &ekstypes.VpcConfigResponse{}
+			if group, ok := tc["cluster_security_group"].(string); ok {
+				vpc.ClusterSecurityGroupId = aws.String(group)
+			}
+			vpc.SecurityGroupIds = testutils.ToStrings(tc["additional_security_groups"])
+			cluster := security.EksCluster{Cluster: &ekstypes.Cluster{Name: aws.String("test-cluster"), ResourcesVpcConfig: vpc}}
+			if noCluster, _ := tc["no_cluster"].(bool); noCluster {
+				cluster.Cluster = nil
+			}
+
+			// 함수 실행 및 반환값 비교
+			result := security.EvaluateSecurityGroupExposure(context.Background(), set.Clientset(), ec2API, toNodegroups(tc["nodegroups"]), cluster, restrictEgress)
+			testutils.AssertCheckResult(t, tc, expectPass, result)
+		})
+	}
+}
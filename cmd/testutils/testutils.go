@@ -1,10 +1,19 @@
 package testutils
 
 import (
+	"bytes"
+	"compress/zlib"
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 	"testing"
 
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/manifests"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -30,3 +39,85 @@ func LoadTestCases(t *testing.T, filePath string) []map[string]interface{} {
 
 	return cases
 }
+
+// ToStrings는 YAML 테스트 데이터의 문자열 목록을 []string으로 변환합니다. (값이 없으면 nil)
+func ToStrings(v interface{}) []string {
+	var result []string
+	if v == nil {
+		return result
+	}
+	for _, item := range v.([]interface{}) {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
+// LoadManifests는 테스트 케이스의 objects를 scan-manifests와 같이 적재합니다.
+// 워크로드에서 컨트롤러가 만들었을 ReplicaSet/Job/Pod도 함께 생성됩니다.
+func LoadManifests(t *testing.T, objects interface{}) *manifests.Set {
+	t.Helper()
+	var docs []string
+	for _, object := range objects.([]interface{}) {
+		data, err := yaml.Marshal(object)
+		if err != nil {
+			t.Fatalf("객체 변환 실패: %v", err)
+		}
+		docs = append(docs, string(data))
+	}
+	set, err := manifests.Load([]string{"-"}, strings.NewReader(strings.Join(docs, "---\n")), "default")
+	if err != nil {
+		t.Fatalf("매니페스트 적재 실패: %v", err)
+	}
+	return set
+}
+
+// AssertCheckResult는 점검 결과가 expectPass와 같은지, 테스트 케이스의 기대값을 만족하는지 확인합니다.
+//   - expect_resources: 결과에 그대로 포함되어야 하는 리소스
+//   - expect_resource_prefixes: 이 문자열로 시작하는 리소스가 있어야 함
+//   - expect_absent: 이 문자열을 포함하는 리소스가 없어야 함
+//   - expect_message: FailureMsg에 포함되어야 하는 문자열
+func AssertCheckResult(t *testing.T, tc map[string]interface{}, expectPass bool, result common.CheckResult) {
+	t.Helper()
+	name := tc["name"].(string)
+	if result.Passed != expectPass {
+		t.Errorf("Test '%s' failed: expected %v, got %v (%s, %v)", name, expectPass, result.Passed, result.FailureMsg, result.Resources)
+	}
+	for _, expect := range ToStrings(tc["expect_resources"]) {
+		if !slices.Contains(result.Resources, expect) {
+			t.Errorf("Test '%s' failed: expected resource %q, got %v", name, expect, result.Resources)
+		}
+	}
+	for _, prefix := range ToStrings(tc["expect_resource_prefixes"]) {
+		if !slices.ContainsFunc(result.Resources, func(r string) bool { return strings.HasPrefix(r, prefix) }) {
+			t.Errorf("Test '%s' failed: expected resource starting with %q, got %v", name, prefix, result.Resources)
+		}
+	}
+	for _, absent := range ToStrings(tc["expect_absent"]) {
+		if slices.ContainsFunc(result.Resources, func(r string) bool { return strings.Contains(r, absent) }) {
+			t.Errorf("Test '%s' failed: unexpected resource containing %q, got %v", name, absent, result.Resources)
+		}
+	}
+	if msg, ok := tc["expect_message"].(string); ok && !strings.Contains(result.FailureMsg, msg) {
+		t.Errorf("Test '%s' failed: expected message containing %q, got %q", name, msg, result.FailureMsg)
+	}
+}
+
+var pdfStreamPattern = regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`)
+
+// PDFContents는 PDF의 압축된 페이지 콘텐츠를 모두 풀어서 반환합니다.
+func PDFContents(t *testing.T, data []byte) string {
+	t.Helper()
+	var b strings.Builder
+	for _, m := range pdfStreamPattern.FindAllSubmatch(data, -1) {
+		r, err := zlib.NewReader(bytes.NewReader(m[1]))
+		if err != nil {
+			t.Fatalf("invalid content stream: %v", err)
+		}
+		content, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("invalid content stream: %v", err)
+		}
+		b.Write(content)
+	}
+	return b.String()
+}
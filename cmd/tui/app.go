@@ -0,0 +1,330 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"eks-checklist/cmd/common"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// Options TUI 실행 옵션
+type Options struct {
+	// Title 화면 상단에 표시할 제목 (클러스터 이름 등)
+	Title string
+	// Categories 사이드바에 표시할 카테고리 순서
+	Categories []string
+	// Filter 초기 상태 필터 (--filter 값)
+	Filter string
+	// Runbook 런북 로더 (nil이면 FileRunbookLoader)
+	Runbook RunbookLoader
+	// ExportDir 내보내기 파일을 저장할 디렉터리
+	ExportDir string
+}
+
+type app struct {
+	opts  Options
+	items []Item
+
+	category string
+	filter   string
+	query    string
+	visible  []Item
+
+	application *tview.Application
+	header      *tview.TextView
+	sidebar     *tview.List
+	table       *tview.Table
+	detail      *tview.TextView
+	search      *tview.InputField
+	status      *tview.TextView
+	focusOrder  []tview.Primitive
+}
+
+const helpText = "Tab 포커스 전환 | f 상태 필터 | / 검색 | Esc 검색 해제 | e 내보내기 | q 종료"
+
+// Run 체크 결과를 대화형 TUI로 표시
+// 마우스 없이 키보드만으로 조작하며 터미널 기본 색상을 사용하므로 SSH 접속 환경에서도 동작
+func Run(items []Item, opts Options) error {
+	if opts.Runbook == nil {
+		opts.Runbook = FileRunbookLoader
+	}
+	if opts.Filter == "" {
+		opts.Filter = FilterAll
+	}
+	if opts.ExportDir == "" {
+		opts.ExportDir = "output"
+	}
+
+	configureStyles()
+
+	a := &app{opts: opts, items: items, filter: opts.Filter}
+	a.build()
+	a.refresh()
+
+	return a.application.Run()
+}
+
+// configureStyles 터미널 기본 배경색을 사용하고, UTF-8 로케일이 아니면 ASCII 테두리 사용
+func configureStyles() {
+	tview.Styles.PrimitiveBackgroundColor = tcell.ColorDefault
+	tview.Styles.ContrastBackgroundColor = tcell.ColorDefault
+	tview.Styles.MoreContrastBackgroundColor = tcell.ColorDefault
+
+	locale := os.Getenv("LC_ALL") + os.Getenv("LC_CTYPE") + os.Getenv("LANG")
+	if !strings.Contains(strings.ToUpper(strings.ReplaceAll(locale, "-", "")), "UTF8") {
+		tview.Borders.Horizontal = '-'
+		tview.Borders.Vertical = '|'
+		tview.Borders.TopLeft = '+'
+		tview.Borders.TopRight = '+'
+		tview.Borders.BottomLeft = '+'
+		tview.Borders.BottomRight = '+'
+		tview.Borders.HorizontalFocus = '='
+		tview.Borders.VerticalFocus = '|'
+		tview.Borders.TopLeftFocus = '+'
+		tview.Borders.TopRightFocus = '+'
+		tview.Borders.BottomLeftFocus = '+'
+		tview.Borders.BottomRightFocus = '+'
+	}
+}
+
+func (a *app) build() {
+	a.application = tview.NewApplication()
+
+	a.header = tview.NewTextView().SetDynamicColors(true)
+
+	a.sidebar = tview.NewList().ShowSecondaryText(false).SetHighlightFullLine(true)
+	a.sidebar.SetBorder(true).SetTitle(" 카테고리 ")
+	a.sidebar.SetChangedFunc(func(index int, _ string, _ string, _ rune) {
+		if index == 0 {
+			a.category = ""
+		} else {
+			a.category = a.opts.Categories[index-1]
+		}
+		a.refresh()
+	})
+	a.sidebar.SetSelectedFunc(func(int, string, string, rune) {
+		a.application.SetFocus(a.table)
+	})
+
+	a.table = tview.NewTable().SetSelectable(true, false).SetFixed(1, 0)
+	a.table.SetBorder(true).SetTitle(" 체크 ")
+	a.table.SetSelectionChangedFunc(func(row, _ int) {
+		a.showDetail(row)
+	})
+	a.table.SetSelectedFunc(func(int, int) {
+		a.application.SetFocus(a.detail)
+	})
+
+	a.detail = tview.NewTextView().SetDynamicColors(true).SetWrap(true).SetScrollable(true)
+	a.detail.SetBorder(true).SetTitle(" 상세 ")
+
+	a.search = tview.NewInputField().SetLabel("검색: ")
+	a.search.SetChangedFunc(func(text string) {
+		a.query = text
+		a.refresh()
+	})
+	a.search.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			a.search.SetText("")
+		}
+		a.application.SetFocus(a.table)
+	})
+
+	a.status = tview.NewTextView().SetDynamicColors(true).SetText(helpText)
+
+	a.focusOrder = []tview.Primitive{a.sidebar, a.table, a.detail}
+
+	main := tview.NewFlex().
+		AddItem(a.sidebar, 28, 0, false).
+		AddItem(a.table, 0, 2, true).
+		AddItem(a.detail, 0, 3, false)
+
+	layout := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(a.header, 1, 0, false).
+		AddItem(main, 0, 1, true).
+		AddItem(a.search, 1, 0, false).
+		AddItem(a.status, 1, 0, false)
+
+	a.application.SetRoot(layout, true).SetFocus(a.table)
+	a.application.SetInputCapture(a.handleKey)
+
+	a.sidebar.AddItem("전체", "", 0, nil)
+	for _, category := range a.opts.Categories {
+		a.sidebar.AddItem(category, "", 0, nil)
+	}
+}
+
+// handleKey 전역 단축키 처리 (검색 입력 중에는 입력 필드로 전달)
+func (a *app) handleKey(event *tcell.EventKey) *tcell.EventKey {
+	if a.application.GetFocus() == a.search {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyTab:
+		a.cycleFocus(1)
+		return nil
+	case tcell.KeyBacktab:
+		a.cycleFocus(-1)
+		return nil
+	case tcell.KeyEscape:
+		if a.query != "" {
+			a.search.SetText("")
+			return nil
+		}
+	case tcell.KeyRune:
+		switch event.Rune() {
+		case 'q':
+			a.application.Stop()
+			return nil
+		case 'f':
+			a.filter = NextFilter(a.filter)
+			a.refresh()
+			return nil
+		case '/':
+			a.application.SetFocus(a.search)
+			return nil
+		case 'e':
+			a.export()
+			return nil
+		}
+	}
+	return event
+}
+
+func (a *app) cycleFocus(step int) {
+	current := a.application.GetFocus()
+	for i, p := range a.focusOrder {
+		if p == current {
+			next := (i + step + len(a.focusOrder)) % len(a.focusOrder)
+			a.application.SetFocus(a.focusOrder[next])
+			return
+		}
+	}
+	a.application.SetFocus(a.table)
+}
+
+// refresh 현재 카테고리/필터/검색어로 체크 목록과 사이드바 개수를 다시 그림
+func (a *app) refresh() {
+	a.visible = Select(a.items, a.category, a.filter, a.query)
+
+	for i := 0; i < a.sidebar.GetItemCount(); i++ {
+		category, name := "", "전체"
+		if i > 0 {
+			category = a.opts.Categories[i-1]
+			name = category
+		}
+		count := len(Select(a.items, category, a.filter, a.query))
+		a.sidebar.SetItemText(i, fmt.Sprintf("%s (%d)", name, count), "")
+	}
+
+	a.table.Clear()
+	a.table.SetCell(0, 0, tview.NewTableCell("상태").SetSelectable(false).SetAttributes(tcell.AttrBold))
+	a.table.SetCell(0, 1, tview.NewTableCell("체크").SetSelectable(false).SetAttributes(tcell.AttrBold).SetExpansion(1))
+	for i, item := range a.visible {
+		label, color := statusLabel(item.Result)
+		a.table.SetCell(i+1, 0, tview.NewTableCell(label).SetTextColor(color))
+		a.table.SetCell(i+1, 1, tview.NewTableCell(tview.Escape(item.Result.CheckName)).SetExpansion(1))
+	}
+
+	query := a.query
+	if query == "" {
+		query = "-"
+	}
+	a.header.SetText(fmt.Sprintf("[::b]EKS Checklist[::-] %s | 필터: [yellow]%s[-] | 검색: %s | %d/%d 항목",
+		tview.Escape(a.opts.Title), a.filter, tview.Escape(query), len(a.visible), len(a.items)))
+
+	if len(a.visible) > 0 {
+		a.table.Select(1, 0)
+		a.showDetail(1)
+	} else {
+		a.detail.SetText("조건에 맞는 체크가 없습니다.")
+	}
+}
+
+// showDetail 선택한 체크의 결과, 리소스, 런북 표시
+func (a *app) showDetail(row int) {
+	if row < 1 || row > len(a.visible) {
+		return
+	}
+	item := a.visible[row-1]
+	r := item.Result
+	label, _ := statusLabel(r)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[::b]%s[::-]\n", tview.Escape(r.CheckName))
+	fmt.Fprintf(&b, "카테고리: %s | 상태: %s\n", tview.Escape(item.Category), label)
+	if r.FailureMsg != "" {
+		fmt.Fprintf(&b, "\n[::b]메시지[::-]\n%s\n", tview.Escape(r.FailureMsg))
+	}
+	if len(r.Resources) > 0 {
+		fmt.Fprintf(&b, "\n[::b]리소스 (%d)[::-]\n", len(r.Resources))
+		for _, resource := range r.Resources {
+			fmt.Fprintf(&b, "  - %s\n", tview.Escape(resource))
+		}
+	}
+
+	fmt.Fprintf(&b, "\n[::b]Runbook[::-] %s\n\n", tview.Escape(r.Runbook))
+	if category, id, ok := RunbookRef(r.Runbook); ok {
+		if markdown, err := a.opts.Runbook(category, id); err == nil {
+			b.WriteString(renderMarkdown(markdown))
+		} else {
+			fmt.Fprintf(&b, "[gray]%s[-]\n", tview.Escape(err.Error()))
+		}
+	}
+
+	a.detail.SetText(b.String()).ScrollToBeginning()
+}
+
+// export 현재 화면에 표시된 체크 결과를 JSON 파일로 저장
+func (a *app) export() {
+	if err := os.MkdirAll(a.opts.ExportDir, 0755); err != nil {
+		a.setStatus("[red]내보내기 실패: " + tview.Escape(err.Error()) + "[-]")
+		return
+	}
+
+	results := make([]common.CheckResult, 0, len(a.visible))
+	for _, item := range a.visible {
+		r := item.Result
+		r.Category = item.Category
+		results = append(results, r)
+	}
+
+	path := filepath.Join(a.opts.ExportDir, fmt.Sprintf("eks-checklist-tui-export-%s.json", time.Now().Format("20060102-150405")))
+	if err := common.SaveAsJSON(results, path); err != nil {
+		a.setStatus("[red]내보내기 실패: " + tview.Escape(err.Error()) + "[-]")
+		return
+	}
+	a.setStatus(fmt.Sprintf("[green]%d개 항목을 내보냈습니다: %s[-]", len(results), tview.Escape(path)))
+}
+
+// setStatus 상태 표시줄에 메시지를 잠시 표시한 뒤 도움말로 되돌림
+func (a *app) setStatus(message string) {
+	a.status.SetText(message)
+	go func() {
+		time.Sleep(5 * time.Second)
+		a.application.QueueUpdateDraw(func() {
+			a.status.SetText(helpText)
+		})
+	}()
+}
+
+func statusLabel(r common.CheckResult) (string, tcell.Color) {
+	switch common.ResultStatus(r) {
+	case "n/a":
+		return "N/A", tcell.ColorGray
+	case "timeout":
+		return "TIMEOUT", tcell.ColorRed
+	case FilterPass:
+		return "PASS", tcell.ColorGreen
+	case FilterManual:
+		return "MANUAL", tcell.ColorYellow
+	}
+	return "FAIL", tcell.ColorRed
+}
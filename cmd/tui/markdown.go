@@ -0,0 +1,54 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// renderMarkdown 런북 마크다운을 tview 색상 태그가 포함된 텍스트로 변환
+// 제목, 코드 블록, 목록, 굵은 글씨 정도만 구분하며 나머지는 원문 그대로 표시
+func renderMarkdown(markdown string) string {
+	var b strings.Builder
+	inCode := false
+
+	for _, line := range strings.Split(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode {
+			b.WriteString("[gray]  " + tview.Escape(line) + "[-]\n")
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "# "):
+			b.WriteString("[yellow::b]" + tview.Escape(strings.TrimPrefix(trimmed, "# ")) + "[-::-]\n")
+		case strings.HasPrefix(trimmed, "#"):
+			b.WriteString("\n[aqua::b]" + tview.Escape(strings.TrimSpace(strings.TrimLeft(trimmed, "#"))) + "[-::-]\n")
+		case strings.HasPrefix(trimmed, "- "), strings.HasPrefix(trimmed, "* "):
+			b.WriteString("  • " + renderInline(trimmed[2:]) + "\n")
+		default:
+			b.WriteString(renderInline(line) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// renderInline **굵게** 표시를 tview 태그로 변환
+func renderInline(text string) string {
+	parts := strings.Split(text, "**")
+	for i := range parts {
+		parts[i] = tview.Escape(parts[i])
+		if i%2 == 1 && i < len(parts)-1 {
+			parts[i] = "[::b]" + parts[i] + "[::-]"
+		} else if i%2 == 1 {
+			// 닫히지 않은 ** 는 원문 유지
+			parts[i] = "**" + parts[i]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,112 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/runbook"
+)
+
+// 상태 필터 (--filter와 동일한 값)
+const (
+	FilterAll    = "all"
+	FilterPass   = "pass"
+	FilterFail   = "fail"
+	FilterManual = "manual"
+)
+
+// Filters 상태 필터 전환 순서
+var Filters = []string{FilterAll, FilterFail, FilterManual, FilterPass}
+
+// Item 카테고리 정보가 포함된 체크 결과
+type Item struct {
+	Category string
+	Result   common.CheckResult
+}
+
+// RunbookLoader 카테고리(docs/runbook 하위 디렉터리)와 체크 ID로 런북 마크다운을 읽음
+type RunbookLoader func(category, id string) (string, error)
+
+// NextFilter 상태 필터 전환 순서상 다음 필터
+func NextFilter(filter string) string {
+	for i, f := range Filters {
+		if f == filter {
+			return Filters[(i+1)%len(Filters)]
+		}
+	}
+	return FilterAll
+}
+
+// Match 카테고리, 상태 필터, 검색어 조건에 맞는지 확인
+// category가 빈 문자열이면 모든 카테고리, 검색어는 체크 이름/메시지/리소스에서 대소문자 구분 없이 검색
+func Match(item Item, category, filter, query string) bool {
+	if category != "" && item.Category != category {
+		return false
+	}
+
+	if filter != "" && filter != FilterAll {
+		// 상태 필터를 지정한 경우 N/A 결과는 제외하고, 시간 초과는 실패로 분류 (텍스트 출력과 동일)
+		status := common.ResultStatus(item.Result)
+		if status == "timeout" {
+			status = FilterFail
+		}
+		if status != filter {
+			return false
+		}
+	}
+
+	query = strings.ToLower(strings.TrimSpace(query))
+	if query == "" {
+		return true
+	}
+	if strings.Contains(strings.ToLower(item.Result.CheckName), query) ||
+		strings.Contains(strings.ToLower(item.Result.FailureMsg), query) {
+		return true
+	}
+	for _, resource := range item.Result.Resources {
+		if strings.Contains(strings.ToLower(resource), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// Select 조건에 맞는 항목만 순서대로 반환
+func Select(items []Item, category, filter, query string) []Item {
+	var selected []Item
+	for _, item := range items {
+		if Match(item, category, filter, query) {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// RunbookRef 런북 URL에서 카테고리와 체크 ID 추출
+func RunbookRef(url string) (string, string, bool) {
+	return runbook.Ref(url)
+}
+
+// FileRunbookLoader docs/runbook/<category>/<ID>.md 파일에서 런북을 읽음
+// 현재 디렉터리, 실행 파일 디렉터리 순으로 찾고, 파일이 없으면 바이너리에 포함된 런북을 사용
+func FileRunbookLoader(category, id string) (string, error) {
+	runbookPath := filepath.Join("docs", "runbook", category, id+".md")
+
+	candidates := []string{runbookPath}
+	if execPath, err := os.Executable(); err == nil {
+		candidates = append(candidates, filepath.Join(filepath.Dir(execPath), runbookPath))
+	}
+
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return string(data), nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+	}
+	return runbook.Load(category, id)
+}
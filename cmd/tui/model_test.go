@@ -0,0 +1,52 @@
+package tui_test
+
+import (
+	"reflect"
+	"testing"
+
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/testutils"
+	"eks-checklist/cmd/tui"
+)
+
+var sampleItems = []tui.Item{
+	{Category: "Security Check", Result: common.CheckResult{CheckName: "[SEC-001] EKS 클러스터 API 엔드포인트 접근 제어", Passed: true,
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-001"}},
+	{Category: "Security Check", Result: common.CheckResult{CheckName: "[SEC-005] 루트 유저가 아닌 유저로 컨테이너 실행",
+		Resources: []string{"Namespace: kube-system | Pod: aws-node"}, Runbook: "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-005"}},
+	{Category: "Security Check", Result: common.CheckResult{CheckName: "[SEC-011] Secret 객체 암호화", NotApplicable: true,
+		FailureMsg: "AWS API가 필요한 체크입니다"}},
+	{Category: "Network Check", Result: common.CheckResult{CheckName: "[NET-002] AWS VPC CNI 네트워크 정책", Manual: true}},
+}
+
+func TestSelect(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "tui_select.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		expect := []string{}
+		for _, id := range tc["expect"].([]interface{}) {
+			expect = append(expect, id.(string))
+		}
+
+		t.Run(testName, func(t *testing.T) {
+			got := []string{}
+			for _, item := range tui.Select(sampleItems, tc["category"].(string), tc["filter"].(string), tc["query"].(string)) {
+				got = append(got, item.Result.CheckName[1:len("[SEC-001")])
+			}
+			if !reflect.DeepEqual(got, expect) {
+				t.Errorf("Test '%s' failed: expected %v, got %v", testName, expect, got)
+			}
+		})
+	}
+}
+
+func TestRunbookRef(t *testing.T) {
+	category, id, ok := tui.RunbookRef("https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-011")
+	if !ok || category != "reliability" || id != "REL-011" {
+		t.Errorf("expected reliability/REL-011, got %s/%s (%v)", category, id, ok)
+	}
+	if _, _, ok := tui.RunbookRef(""); ok {
+		t.Errorf("expected no runbook reference for empty URL")
+	}
+}
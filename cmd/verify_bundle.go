@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"eks-checklist/cmd/bundle"
+	"eks-checklist/cmd/common"
+
+	"github.com/spf13/cobra"
+)
+
+var verifyPublicKeyPath string
+
+var verifyBundleCmd = &cobra.Command{
+	Use:   "verify-bundle FILE",
+	Short: "증적 번들(--bundle)의 SHA-256 목록과 ed25519 서명 검증",
+	Long: `--bundle로 만든 증적 번들의 모든 파일을 SHA256SUMS와 비교해 변조, 누락, 추가된 파일이 없는지 확인합니다.
+--public-key를 지정하면 SHA256SUMS의 ed25519 서명도 확인하며, 이 경우 서명이 없는 번들은 실패로 처리합니다.
+
+  eks-checklist verify-bundle evidence.tar.gz --public-key bundle-key.pub`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var pub ed25519.PublicKey
+		if verifyPublicKeyPath != "" {
+			key, err := bundle.LoadPublicKey(verifyPublicKeyPath)
+			if err != nil {
+				return fmt.Errorf("공개 키를 사용할 수 없습니다: %w", err)
+			}
+			pub = key
+		}
+
+		result, err := bundle.VerifyFile(args[0], pub)
+		if err != nil {
+			return fmt.Errorf("증적 번들을 읽을 수 없습니다: %w", err)
+		}
+
+		meta := result.Metadata
+		fmt.Printf("번들: %s (%s)\n", args[0], result.Name)
+		fmt.Printf("  도구 버전: %s %s\n", meta.Tool, meta.Version)
+		fmt.Printf("  생성 시각: %s\n", meta.CreatedAt.Format(time.RFC3339))
+		if meta.Cluster != "" {
+			cluster := meta.Cluster
+			if meta.Account != "" {
+				cluster += fmt.Sprintf(" (계정 %s, 리전 %s)", meta.Account, meta.Region)
+			}
+			fmt.Printf("  클러스터: %s\n", cluster)
+		}
+		fmt.Printf("  실행 명령: %s\n", invocation(meta))
+		fmt.Printf("  파일: %d개\n", result.Files)
+
+		switch {
+		case result.SignatureVerified:
+			fmt.Println("  서명: " + common.Green + "확인됨 (ed25519)" + common.Reset)
+		case result.Signed && pub == nil:
+			fmt.Println("  서명: 있음 (--public-key를 지정하지 않아 확인하지 않음)")
+		case !result.Signed && pub == nil:
+			fmt.Println("  서명: 없음")
+		}
+
+		if !result.Valid() {
+			for _, problem := range result.Problems {
+				fmt.Println(common.Red + "  ✗ " + problem + common.Reset)
+			}
+			return fmt.Errorf("증적 번들 검증 실패 (문제 %d건)", len(result.Problems))
+		}
+		fmt.Println(common.Green + "✓ 증적 번들 검증 성공" + common.Reset)
+		return nil
+	},
+}
+
+// invocation 번들 정보의 실행 명령과 플래그를 한 줄로 표시 (플래그는 이름순)
+func invocation(meta bundle.Metadata) string {
+	parts := []string{meta.Command}
+	names := make([]string, 0, len(meta.Flags))
+	for name := range meta.Flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("--%s=%s", name, meta.Flags[name]))
+	}
+	parts = append(parts, meta.Args...)
+	return strings.Join(parts, " ")
+}
+
+func init() {
+	verifyBundleCmd.Flags().StringVar(&verifyPublicKeyPath, "public-key", "", "서명 확인에 사용할 ed25519 공개 키 (PEM, PKIX)")
+
+	rootCmd.AddCommand(verifyBundleCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/watch"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/client-go/restmapper"
+)
+
+// runWatch 인포머로 리소스 변경을 감시하면서 영향받는 체크만 다시 실행
+func runWatch(ctx context.Context, env *checks.Env) {
+	format := strings.ToLower(watchFormat)
+	if format != watch.FormatText && format != watch.FormatJSON {
+		fmt.Printf("오류: 유효하지 않은 감시 출력 형식 '%s'\n", watchFormat)
+		fmt.Println("유효한 값: text, json")
+		os.Exit(1)
+	}
+
+	groupResources, err := restmapper.GetAPIGroupResources(env.Client.Discovery())
+	if err != nil {
+		fmt.Println("API 리소스 목록 조회 실패:", err)
+		os.Exit(1)
+	}
+
+	watcher := watch.New(watch.Options{
+		Env:          env,
+		Checks:       checks.All,
+		Metadata:     env.MetadataClient,
+		Mapper:       restmapper.NewDiscoveryRESTMapper(groupResources),
+		Debounce:     watchDebounce,
+		CheckTimeout: checkTimeout,
+		AWSInterval:  watchAWSInterval,
+		RefreshCluster: func(ctx context.Context) (*types.Cluster, error) {
+			eksCluster, err := Describe(ctx, env.ClusterName, env.AWSConfig)
+			return eksCluster.Cluster, err
+		},
+		Out:    os.Stdout,
+		Format: format,
+	})
+
+	if err := watcher.Run(ctx); err != nil {
+		fmt.Println("감시 모드 오류:", err)
+		os.Exit(1)
+	}
+}
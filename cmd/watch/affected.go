@@ -0,0 +1,60 @@
+package watch
+
+import (
+	"sort"
+
+	"eks-checklist/cmd/checks"
+)
+
+// derivedResources 워크로드 변경 시 함께 변경되는 하위 리소스
+// (예: Deployment 변경은 ReplicaSet과 Pod 교체로 이어지므로 해당 리소스를 조회하는 체크도 다시 실행)
+var derivedResources = map[string][]string{
+	"deployments.apps": {"replicasets.apps", "pods"},
+	"replicasets.apps": {"pods"},
+	"daemonsets.apps":  {"pods"},
+}
+
+// WatchedResources 이벤트 기반으로 다시 실행할 체크가 조회하는 리소스 목록 (중복 제거, 정렬)
+func WatchedResources(all []checks.Check) []string {
+	seen := make(map[string]bool)
+	var resources []string
+	for _, check := range all {
+		if check.RequiresAWS {
+			continue
+		}
+		for _, resource := range check.Resources {
+			if !seen[resource] {
+				seen[resource] = true
+				resources = append(resources, resource)
+			}
+		}
+	}
+	sort.Strings(resources)
+	return resources
+}
+
+// Affected 변경된 리소스를 조회하는 체크 목록 (등록 순서 유지)
+// AWS API가 필요한 체크는 이벤트로 다시 실행하지 않고 별도 주기로 갱신
+func Affected(all []checks.Check, changed []string) []checks.Check {
+	expanded := make(map[string]bool)
+	for _, resource := range changed {
+		expanded[resource] = true
+		for _, derived := range derivedResources[resource] {
+			expanded[derived] = true
+		}
+	}
+
+	var affected []checks.Check
+	for _, check := range all {
+		if check.RequiresAWS {
+			continue
+		}
+		for _, resource := range check.Resources {
+			if expanded[resource] {
+				affected = append(affected, check)
+				break
+			}
+		}
+	}
+	return affected
+}
@@ -0,0 +1,98 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"eks-checklist/cmd/common"
+)
+
+// 텍스트 출력 시 체크별로 표시할 최대 리소스 변경 수
+const maxTextResources = 10
+
+// Change 체크 결과 변경 내역
+type Change struct {
+	Time     time.Time `json:"time"`
+	ID       string    `json:"id"`
+	Check    string    `json:"check"`
+	Category string    `json:"category"`
+	// From 이전 상태 (최초 실행 결과이면 빈 문자열)
+	From    string   `json:"from,omitempty"`
+	To      string   `json:"to"`
+	Message string   `json:"message,omitempty"`
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+	// Trigger 체크를 다시 실행하게 만든 리소스 (AWS 체크 주기 갱신이면 "aws")
+	Trigger []string `json:"trigger,omitempty"`
+}
+
+// Diff 이전 결과와 현재 결과를 비교하여 상태 또는 결과 리소스가 바뀐 경우 변경 내역 반환
+// prev가 nil이면 최초 실행 결과로 보고 항상 변경 내역을 반환
+func Diff(prev *common.CheckResult, cur common.CheckResult) (Change, bool) {
+	change := Change{
+		Check: cur.CheckName,
+		To:    common.ResultStatus(cur),
+	}
+	// 통과한 체크도 FailureMsg가 채워져 있는 경우가 있어 통과 이외의 상태에서만 메시지 포함
+	if change.To != "pass" {
+		change.Message = cur.FailureMsg
+	}
+	if prev == nil {
+		return change, true
+	}
+
+	change.From = common.ResultStatus(*prev)
+	change.Added = subtract(cur.Resources, prev.Resources)
+	change.Removed = subtract(prev.Resources, cur.Resources)
+
+	changed := change.From != change.To || len(change.Added) > 0 || len(change.Removed) > 0
+	return change, changed
+}
+
+// subtract a에는 있고 b에는 없는 항목 (a의 순서 유지)
+func subtract(a, b []string) []string {
+	exists := make(map[string]bool, len(b))
+	for _, item := range b {
+		exists[item] = true
+	}
+
+	var diff []string
+	for _, item := range a {
+		if !exists[item] {
+			diff = append(diff, item)
+		}
+	}
+	return diff
+}
+
+// writeText 변경 내역을 한 줄 요약과 리소스 증감 목록으로 출력
+func writeText(out io.Writer, change Change) {
+	status := strings.ToUpper(change.To)
+	if change.From != "" && change.From != change.To {
+		status = strings.ToUpper(change.From) + " → " + status
+	}
+
+	line := fmt.Sprintf("[%s] %s | %s", change.Time.Format("15:04:05"), status, change.Check)
+	if len(change.Trigger) > 0 {
+		line += " (변경: " + strings.Join(change.Trigger, ", ") + ")"
+	}
+	fmt.Fprintln(out, line)
+
+	if change.From != change.To && change.Message != "" {
+		fmt.Fprintf(out, "   └─ 🔸 %s\n", change.Message)
+	}
+	writeResources(out, "+", change.Added)
+	writeResources(out, "-", change.Removed)
+}
+
+func writeResources(out io.Writer, sign string, resources []string) {
+	for i, resource := range resources {
+		if i == maxTextResources {
+			fmt.Fprintf(out, "   %s ... 외 %d개\n", sign, len(resources)-maxTextResources)
+			return
+		}
+		fmt.Fprintf(out, "   %s %s\n", sign, resource)
+	}
+}
@@ -0,0 +1,80 @@
+package watch_test
+
+import (
+	"reflect"
+	"testing"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+	"eks-checklist/cmd/testutils"
+	"eks-checklist/cmd/watch"
+)
+
+func toResult(v interface{}) common.CheckResult {
+	m := v.(map[string]interface{})
+	return common.CheckResult{
+		CheckName: "[TEST-001] 테스트",
+		Passed:    m["passed"].(bool),
+		Manual:    m["manual"].(bool),
+		Resources: testutils.ToStrings(m["resources"]),
+	}
+}
+
+func TestAffected(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "watch_affected.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			affected := make(map[string]bool)
+			for _, check := range watch.Affected(checks.All, testutils.ToStrings(tc["changed"])) {
+				if check.RequiresAWS {
+					t.Errorf("Test '%s' failed: AWS check %s should not be re-run by events", testName, check.ID)
+				}
+				affected[check.ID] = true
+			}
+
+			for _, id := range testutils.ToStrings(tc["expect_contains"]) {
+				if !affected[id] {
+					t.Errorf("Test '%s' failed: expected %s to be affected", testName, id)
+				}
+			}
+			for _, id := range testutils.ToStrings(tc["expect_excludes"]) {
+				if affected[id] {
+					t.Errorf("Test '%s' failed: expected %s not to be affected", testName, id)
+				}
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "watch_diff.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			var prev *common.CheckResult
+			if tc["prev"] != nil {
+				r := toResult(tc["prev"])
+				prev = &r
+			}
+
+			change, changed := watch.Diff(prev, toResult(tc["cur"]))
+			if changed != tc["expect_changed"].(bool) {
+				t.Errorf("Test '%s' failed: expected changed=%v, got %v", testName, tc["expect_changed"], changed)
+			}
+			if change.From != tc["expect_from"].(string) || change.To != tc["expect_to"].(string) {
+				t.Errorf("Test '%s' failed: expected %s -> %s, got %s -> %s", testName, tc["expect_from"], tc["expect_to"], change.From, change.To)
+			}
+			if added := append([]string{}, change.Added...); !reflect.DeepEqual(added, append([]string{}, testutils.ToStrings(tc["expect_added"])...)) {
+				t.Errorf("Test '%s' failed: expected added %v, got %v", testName, tc["expect_added"], added)
+			}
+			if removed := append([]string{}, change.Removed...); !reflect.DeepEqual(removed, append([]string{}, testutils.ToStrings(tc["expect_removed"])...)) {
+				t.Errorf("Test '%s' failed: expected removed %v, got %v", testName, tc["expect_removed"], removed)
+			}
+		})
+	}
+}
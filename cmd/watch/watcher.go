@@ -0,0 +1,300 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/tools/cache"
+)
+
+// 출력 형식
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// Options 감시 모드 실행 옵션
+type Options struct {
+	// Env 체크 실행 환경 (Watcher는 이 값을 변경하지 않고, 클러스터 정보를 갱신할 때는 복사본을 만들어 사용)
+	Env    *checks.Env
+	Checks []checks.Check
+	// Metadata 인포머에 사용할 메타데이터 클라이언트 (객체 본문 없이 메타데이터만 캐시)
+	Metadata metadata.Interface
+	// Mapper "resource.group" 형식의 리소스를 API 버전으로 변환
+	Mapper meta.RESTMapper
+	// Debounce 리소스 변경 후 체크를 다시 실행하기까지 기다리는 시간 (연속 변경을 한 번에 처리)
+	Debounce time.Duration
+	// CheckTimeout 체크별 최대 실행 시간 (0이면 제한 없음)
+	CheckTimeout time.Duration
+	// AWSInterval AWS API가 필요한 체크의 갱신 주기 (0이면 최초 1회만 실행)
+	AWSInterval time.Duration
+	// RefreshCluster AWS 체크 갱신 전 DescribeCluster 결과를 다시 조회 (nil이면 기존 값 사용)
+	RefreshCluster func(ctx context.Context) (*types.Cluster, error)
+	Out            io.Writer
+	Format         string
+}
+
+// Watcher 인포머 이벤트로 영향받는 체크만 다시 실행하고 상태 변경을 출력
+type Watcher struct {
+	opts Options
+	// env 현재 체크 실행 환경 (Run 고루틴에서만 교체)
+	// 시간 초과 후에도 실행 중인 체크가 참조할 수 있으므로 기존 값을 수정하지 않고 새 복사본으로 교체
+	env *checks.Env
+
+	mu      sync.Mutex
+	pending map[string]bool
+	notify  chan struct{}
+
+	last map[string]common.CheckResult
+}
+
+// New 감시 모드 실행기 생성
+func New(opts Options) *Watcher {
+	if opts.Debounce <= 0 {
+		opts.Debounce = 5 * time.Second
+	}
+	if opts.Format == "" {
+		opts.Format = FormatText
+	}
+	return &Watcher{
+		opts:    opts,
+		env:     opts.Env,
+		pending: make(map[string]bool),
+		notify:  make(chan struct{}, 1),
+		last:    make(map[string]common.CheckResult),
+	}
+}
+
+// Run 인포머를 시작하고 ctx가 취소될 때까지 변경 사항을 감시
+func (w *Watcher) Run(ctx context.Context) error {
+	factory := metadatainformer.NewSharedInformerFactoryWithOptions(w.opts.Metadata, 0,
+		metadatainformer.WithTransform(stripManagedFields))
+	defer factory.Shutdown()
+
+	for _, resource := range WatchedResources(w.opts.Checks) {
+		gvr, err := w.resolve(resource)
+		if err != nil {
+			w.logf("감시 제외: %s (%v)", resource, err)
+			continue
+		}
+		informer := factory.ForResource(gvr).Informer()
+		if _, err := informer.AddEventHandler(w.handler(resource)); err != nil {
+			return fmt.Errorf("%s 인포머 등록 실패: %w", resource, err)
+		}
+	}
+
+	factory.Start(ctx.Done())
+	for gvr, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("%s 캐시 동기화 실패", gvr.String())
+		}
+	}
+
+	w.runChecks(ctx, w.env, w.opts.Checks, nil)
+	w.printBaseline()
+
+	var awsTick <-chan time.Time
+	if w.opts.AWSInterval > 0 {
+		ticker := time.NewTicker(w.opts.AWSInterval)
+		defer ticker.Stop()
+		awsTick = ticker.C
+	}
+
+	var debounce *time.Timer
+	var debounceC <-chan time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.notify:
+			// 첫 변경 이후 Debounce 동안 들어온 변경은 함께 처리
+			if debounce == nil {
+				debounce = time.NewTimer(w.opts.Debounce)
+				debounceC = debounce.C
+			}
+		case <-debounceC:
+			debounce, debounceC = nil, nil
+			changed := w.takePending()
+			w.runChecks(ctx, w.env, Affected(w.opts.Checks, changed), changed)
+		case <-awsTick:
+			w.refreshAWS(ctx)
+		}
+	}
+}
+
+// resolve "deployments.apps" 형식의 리소스를 클러스터에서 제공하는 GVR로 변환
+func (w *Watcher) resolve(resource string) (schema.GroupVersionResource, error) {
+	name, group, _ := strings.Cut(resource, ".")
+	return w.opts.Mapper.ResourceFor(schema.GroupVersionResource{Group: group, Resource: name})
+}
+
+// handler 리소스 변경을 대기 목록에 추가하는 이벤트 핸들러
+// 최초 목록 조회와 resourceVersion이 같은 재동기화 이벤트는 무시
+func (w *Watcher) handler(resource string) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if !isInInitialList {
+				w.markChanged(resource)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldMeta, err1 := meta.Accessor(oldObj)
+			newMeta, err2 := meta.Accessor(newObj)
+			if err1 == nil && err2 == nil && oldMeta.GetResourceVersion() == newMeta.GetResourceVersion() {
+				return
+			}
+			w.markChanged(resource)
+		},
+		DeleteFunc: func(obj interface{}) {
+			w.markChanged(resource)
+		},
+	}
+}
+
+func (w *Watcher) markChanged(resource string) {
+	w.mu.Lock()
+	w.pending[resource] = true
+	w.mu.Unlock()
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) takePending() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	changed := make([]string, 0, len(w.pending))
+	for resource := range w.pending {
+		changed = append(changed, resource)
+	}
+	w.pending = make(map[string]bool)
+	sort.Strings(changed)
+	return changed
+}
+
+// refreshAWS 클러스터 정보를 다시 조회한 뒤 AWS API가 필요한 체크를 실행
+// 조회한 클러스터 정보는 Env 복사본에 담아 교체하므로 이전 실행에서 시간 초과된 체크와 경합하지 않음
+func (w *Watcher) refreshAWS(ctx context.Context) {
+	if w.opts.RefreshCluster != nil {
+		cluster, err := w.opts.RefreshCluster(ctx)
+		if err != nil {
+			w.logf("클러스터 정보 갱신 실패: %v", err)
+		} else {
+			env := *w.env
+			env.Cluster = cluster
+			w.env = &env
+		}
+	}
+
+	var awsChecks []checks.Check
+	for _, check := range w.opts.Checks {
+		if check.RequiresAWS {
+			awsChecks = append(awsChecks, check)
+		}
+	}
+	w.runChecks(ctx, w.env, awsChecks, []string{"aws"})
+}
+
+// runChecks 체크를 순서대로 실행하고 이전 결과와 달라진 항목을 출력
+// trigger가 nil이면 최초 실행으로 보고 출력하지 않음 (printBaseline에서 요약 출력)
+func (w *Watcher) runChecks(ctx context.Context, env *checks.Env, list []checks.Check, trigger []string) {
+	for _, check := range list {
+		if ctx.Err() != nil {
+			return
+		}
+
+		result := w.execute(ctx, env, check)
+		var prev *common.CheckResult
+		if r, ok := w.last[check.ID]; ok {
+			prev = &r
+		}
+		w.last[check.ID] = result
+
+		change, changed := Diff(prev, result)
+		if !changed || (trigger == nil && w.opts.Format == FormatText) {
+			continue
+		}
+		change.Time = time.Now()
+		change.ID = check.ID
+		change.Category = check.Category
+		change.Trigger = trigger
+		w.emit(change)
+	}
+}
+
+// execute 체크별 시간 제한을 적용해 실행
+func (w *Watcher) execute(ctx context.Context, env *checks.Env, check checks.Check) common.CheckResult {
+	if w.opts.CheckTimeout <= 0 {
+		return check.Execute(ctx, env)
+	}
+	checkCtx, cancel := context.WithTimeout(ctx, w.opts.CheckTimeout)
+	defer cancel()
+	return check.Execute(checkCtx, env)
+}
+
+func (w *Watcher) printBaseline() {
+	if w.opts.Format != FormatText {
+		return
+	}
+
+	counts := make(map[string]int)
+	for _, result := range w.last {
+		counts[common.ResultStatus(result)]++
+	}
+	fmt.Fprintf(w.opts.Out, "[%s] 초기 점검 완료: PASS %d | FAIL %d | MANUAL %d\n",
+		time.Now().Format("15:04:05"), counts["pass"], counts["fail"], counts["manual"])
+	for _, check := range w.opts.Checks {
+		if result, ok := w.last[check.ID]; ok && common.ResultStatus(result) == "fail" {
+			fmt.Fprintf(w.opts.Out, "   ❌ %s\n", result.CheckName)
+		}
+	}
+	fmt.Fprintln(w.opts.Out, "변경 사항을 감시합니다 (Ctrl-C로 종료)")
+}
+
+func (w *Watcher) emit(change Change) {
+	if w.opts.Format == FormatJSON {
+		data, err := json.Marshal(change)
+		if err != nil {
+			return
+		}
+		fmt.Fprintln(w.opts.Out, string(data))
+		return
+	}
+	writeText(w.opts.Out, change)
+}
+
+// logf 진행 메시지 출력 (JSON 출력 시에는 결과 스트림을 오염시키지 않도록 생략)
+func (w *Watcher) logf(format string, args ...interface{}) {
+	if w.opts.Format == FormatJSON {
+		return
+	}
+	fmt.Fprintf(w.opts.Out, format+"\n", args...)
+}
+
+// stripManagedFields 캐시 메모리 절약을 위해 managedFields 제거
+func stripManagedFields(obj interface{}) (interface{}, error) {
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetManagedFields(nil)
+	}
+	return obj, nil
+}
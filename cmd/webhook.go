@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"eks-checklist/cmd/kube"
+	"eks-checklist/cmd/webhook"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+var (
+	webhookAddr                   string
+	webhookCertFile               string
+	webhookKeyFile                string
+	webhookMode                   string
+	webhookCheckModes             map[string]string
+	webhookNamespaceSelector      string
+	webhookCheckNamespaceSelector []string
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "워크로드 체크를 ValidatingAdmissionWebhook으로 실행",
+	Long: `Pod 및 Pod 템플릿(Deployment, StatefulSet, DaemonSet, ReplicaSet, Job, CronJob)을
+admission 시점에 GEN-003, SEC-005, SEC-014, REL-003, REL-005, REL-007 기준으로 평가합니다.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		config, err := buildWebhookConfig()
+		if err != nil {
+			return err
+		}
+
+		// 네임스페이스 셀렉터를 사용할 때만 클러스터에 접근
+		var client kubernetes.Interface
+		if config.NamespaceSelector != nil || len(webhookCheckNamespaceSelector) > 0 {
+			restConfig, err := rest.InClusterConfig()
+			if err != nil {
+				restConfig, err = getKubeconfigWithContext(kubeconfigPath, kubeconfigContext, awsProfile)
+				if err != nil {
+					return err
+				}
+			}
+			kube.ConfigureRateLimit(restConfig, kubeQPS, kubeBurst)
+			client, err = kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return fmt.Errorf("Kubernetes 클라이언트 생성 실패: %v", err)
+			}
+		}
+
+		mux := http.NewServeMux()
+		mux.Handle("/validate", webhook.NewHandler(config, client))
+		mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})
+
+		fmt.Printf("Admission webhook 서버 시작: %s\n", webhookAddr)
+		server := &http.Server{
+			Addr:              webhookAddr,
+			Handler:           mux,
+			ReadHeaderTimeout: 5 * time.Second,
+			ReadTimeout:       10 * time.Second,
+			WriteTimeout:      10 * time.Second,
+			IdleTimeout:       60 * time.Second,
+		}
+		return server.ListenAndServeTLS(webhookCertFile, webhookKeyFile)
+	},
+}
+
+// buildWebhookConfig 플래그 값을 웹훅 설정으로 변환
+func buildWebhookConfig() (webhook.Config, error) {
+	var config webhook.Config
+
+	mode, err := webhook.ParseMode(webhookMode)
+	if err != nil {
+		return config, err
+	}
+	config.DefaultMode = mode
+	config.Checks = make(map[string]webhook.CheckPolicy)
+
+	if webhookNamespaceSelector != "" {
+		selector, err := labels.Parse(webhookNamespaceSelector)
+		if err != nil {
+			return config, fmt.Errorf("유효하지 않은 네임스페이스 셀렉터 '%s': %v", webhookNamespaceSelector, err)
+		}
+		config.NamespaceSelector = selector
+	}
+
+	for id, value := range webhookCheckModes {
+		id = strings.ToUpper(id)
+		if _, ok := webhook.FindRule(id); !ok {
+			return config, fmt.Errorf("웹훅에서 지원하지 않는 체크 '%s'", id)
+		}
+		mode, err := webhook.ParseMode(value)
+		if err != nil {
+			return config, err
+		}
+		policy := config.Checks[id]
+		policy.Mode = mode
+		config.Checks[id] = policy
+	}
+
+	// 형식: <체크 ID>:<라벨 셀렉터> (예: SEC-005:env=prod)
+	for _, value := range webhookCheckNamespaceSelector {
+		id, expr, found := strings.Cut(value, ":")
+		if !found {
+			return config, fmt.Errorf("유효하지 않은 체크별 네임스페이스 셀렉터 '%s' (형식: SEC-005:env=prod)", value)
+		}
+		id = strings.ToUpper(id)
+		if _, ok := webhook.FindRule(id); !ok {
+			return config, fmt.Errorf("웹훅에서 지원하지 않는 체크 '%s'", id)
+		}
+		selector, err := labels.Parse(expr)
+		if err != nil {
+			return config, fmt.Errorf("유효하지 않은 네임스페이스 셀렉터 '%s': %v", expr, err)
+		}
+		policy := config.Checks[id]
+		policy.NamespaceSelector = selector
+		config.Checks[id] = policy
+	}
+
+	return config, nil
+}
+
+func init() {
+	webhookCmd.Flags().StringVar(&webhookAddr, "listen-address", ":8443", "웹훅 서버 주소")
+	webhookCmd.Flags().StringVar(&webhookCertFile, "tls-cert-file", "", "TLS 인증서 파일 경로")
+	webhookCmd.Flags().StringVar(&webhookKeyFile, "tls-private-key-file", "", "TLS 개인 키 파일 경로")
+	webhookCmd.Flags().StringVar(&webhookMode, "mode", "warn", "기본 동작 모드 (warn, deny, off)")
+	webhookCmd.Flags().StringToStringVar(&webhookCheckModes, "check-mode", nil, "체크별 동작 모드 (예: GEN-003=deny,REL-005=off)")
+	webhookCmd.Flags().StringVar(&webhookNamespaceSelector, "namespace-selector", "", "평가 대상 네임스페이스 라벨 셀렉터 (예: env in (prod,stage))")
+	webhookCmd.Flags().StringArrayVar(&webhookCheckNamespaceSelector, "check-namespace-selector", nil, "체크별 네임스페이스 라벨 셀렉터 (예: SEC-005:env=prod)")
+	webhookCmd.MarkFlagRequired("tls-cert-file")
+	webhookCmd.MarkFlagRequired("tls-private-key-file")
+
+	rootCmd.AddCommand(webhookCmd)
+}
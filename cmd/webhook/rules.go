@@ -0,0 +1,84 @@
+package webhook
+
+import (
+	"eks-checklist/cmd/general"
+	"eks-checklist/cmd/reliability"
+	"eks-checklist/cmd/security"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Rule admission 시점에 PodSpec 하나로 평가할 수 있는 체크 항목
+type Rule struct {
+	ID      string
+	Name    string
+	Runbook string
+	// Skip 체크와 동일한 기준으로 평가 대상에서 제외할지 판단
+	Skip func(namespace string, meta metav1.ObjectMeta, spec corev1.PodSpec) bool
+	// Check 위반 사항을 컨테이너 단위 문자열로 반환
+	Check func(spec corev1.PodSpec) []string
+}
+
+// Rules 웹훅에서 평가하는 체크 목록 (클러스터 점검과 동일한 판정 로직 사용)
+var Rules = []Rule{
+	{
+		ID:      "GEN-003",
+		Name:    "컨테이너 이미지 태그에 latest 미사용",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/general/GEN-003",
+		Check:   general.LatestImageTagViolations,
+	},
+	{
+		ID:      "SEC-005",
+		Name:    "루트 유저가 아닌 유저로 컨테이너 실행",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-005",
+		Skip: func(namespace string, meta metav1.ObjectMeta, spec corev1.PodSpec) bool {
+			return security.IsExcludedFromContainerUserCheck(meta.Name, meta.Labels)
+		},
+		Check: security.ContainerUserViolations,
+	},
+	{
+		ID:      "SEC-014",
+		Name:    "읽기 전용 파일시스템 사용",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/security/SEC-014",
+		Skip: func(namespace string, meta metav1.ObjectMeta, spec corev1.PodSpec) bool {
+			// 아직 노드에 배치되지 않았으므로 nodeSelector로 Windows 워크로드를 판단
+			return namespace == "kube-system" || spec.NodeSelector["kubernetes.io/os"] == "windows"
+		},
+		Check: security.ReadonlyFilesystemViolations,
+	},
+	{
+		ID:      "REL-003",
+		Name:    "동일한 역할을 하는 Pod를 다수의 노드에 분산 배포",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-003",
+		Check:   reliability.DistributionViolations,
+	},
+	{
+		ID:      "REL-005",
+		Name:    "Probe(Startup, Readiness, Liveness) 적용",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-005",
+		Skip:    skipKubeSystem,
+		Check:   reliability.ProbeViolations,
+	},
+	{
+		ID:      "REL-007",
+		Name:    "애플리케이션에 적절한 CPU/RAM 할당",
+		Runbook: "https://fitcloud.github.io/eks-checklist/runbook/reliability/REL-007",
+		Skip:    skipKubeSystem,
+		Check:   reliability.ResourceAllocationViolations,
+	},
+}
+
+func skipKubeSystem(namespace string, meta metav1.ObjectMeta, spec corev1.PodSpec) bool {
+	return namespace == "kube-system"
+}
+
+// FindRule ID로 체크를 찾음
+func FindRule(id string) (Rule, bool) {
+	for _, rule := range Rules {
+		if rule.ID == id {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
@@ -0,0 +1,274 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Mode 체크 위반 시 웹훅의 동작 방식
+type Mode string
+
+const (
+	ModeWarn Mode = "warn" // 허용하되 admission 경고 반환
+	ModeDeny Mode = "deny" // 요청 거부
+	ModeOff  Mode = "off"  // 평가하지 않음
+)
+
+// ParseMode 문자열을 Mode로 변환
+func ParseMode(s string) (Mode, error) {
+	switch Mode(strings.ToLower(s)) {
+	case ModeWarn:
+		return ModeWarn, nil
+	case ModeDeny:
+		return ModeDeny, nil
+	case ModeOff:
+		return ModeOff, nil
+	}
+	return "", fmt.Errorf("유효하지 않은 모드 '%s' (유효한 값: warn, deny, off)", s)
+}
+
+// CheckPolicy 체크별 모드와 네임스페이스 셀렉터 설정
+type CheckPolicy struct {
+	Mode              Mode
+	NamespaceSelector labels.Selector
+}
+
+// Config 웹훅 동작 설정
+type Config struct {
+	// DefaultMode 체크별 설정이 없을 때 사용할 모드
+	DefaultMode Mode
+	// NamespaceSelector 평가 대상 네임스페이스 (nil이면 전체)
+	NamespaceSelector labels.Selector
+	// Checks 체크 ID별 설정 (기본값을 덮어씀)
+	Checks map[string]CheckPolicy
+}
+
+// MaxRequestBytes AdmissionReview 요청 본문의 최대 크기 (초과 시 413 응답)
+const MaxRequestBytes = 3 << 20
+
+// Handler ValidatingAdmissionWebhook 요청을 처리하는 HTTP 핸들러
+type Handler struct {
+	config Config
+	client kubernetes.Interface
+}
+
+// NewHandler Handler 생성. 네임스페이스 셀렉터를 사용하지 않으면 client는 nil이어도 됨
+func NewHandler(config Config, client kubernetes.Interface) *Handler {
+	if config.DefaultMode == "" {
+		config.DefaultMode = ModeWarn
+	}
+	return &Handler{config: config, client: client}
+}
+
+// ServeHTTP AdmissionReview 요청을 디코딩하여 평가 결과를 응답
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST 요청만 지원합니다", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxRequestBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, fmt.Sprintf("요청 본문이 최대 크기(%d바이트)를 초과했습니다", maxBytesErr.Limit), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, "요청 본문 읽기 실패: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var review admissionv1.AdmissionReview
+	if err := json.Unmarshal(body, &review); err != nil || review.Request == nil {
+		http.Error(w, "AdmissionReview 디코딩 실패", http.StatusBadRequest)
+		return
+	}
+
+	response := h.Review(r.Context(), review.Request)
+	response.UID = review.Request.UID
+
+	review.Request = nil
+	review.Response = response
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(review); err != nil {
+		http.Error(w, "응답 인코딩 실패: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Review AdmissionRequest에 포함된 Pod 또는 Pod 템플릿을 평가
+func (h *Handler) Review(ctx context.Context, req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	response := &admissionv1.AdmissionResponse{Allowed: true}
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return response
+	}
+
+	meta, spec, ok, err := extractPodSpec(req.Kind.Kind, req.Object.Raw)
+	if err != nil {
+		// 디코딩할 수 없는 객체 때문에 배포를 막지 않음
+		response.Warnings = append(response.Warnings, "eks-checklist: 객체 디코딩 실패: "+err.Error())
+		return response
+	}
+	if !ok {
+		return response
+	}
+
+	var namespaceLabels labels.Set
+	if h.usesNamespaceSelector() {
+		namespaceLabels, err = h.namespaceLabels(ctx, req.Namespace)
+		if err != nil {
+			response.Warnings = append(response.Warnings, "eks-checklist: 네임스페이스 조회 실패: "+err.Error())
+			return response
+		}
+	}
+
+	var denied []string
+	for _, rule := range Rules {
+		policy := h.policyFor(rule.ID)
+		if policy.Mode == ModeOff {
+			continue
+		}
+		if policy.NamespaceSelector != nil && !policy.NamespaceSelector.Matches(namespaceLabels) {
+			continue
+		}
+		if rule.Skip != nil && rule.Skip(req.Namespace, meta, spec) {
+			continue
+		}
+
+		for _, violation := range rule.Check(spec) {
+			message := fmt.Sprintf("[%s] %s (Runbook: %s)", rule.ID, violation, rule.Runbook)
+			if policy.Mode == ModeDeny {
+				denied = append(denied, message)
+			} else {
+				response.Warnings = append(response.Warnings, message)
+			}
+		}
+	}
+
+	if len(denied) > 0 {
+		response.Allowed = false
+		response.Result = &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: "eks-checklist 정책 위반: " + strings.Join(denied, "; "),
+		}
+	}
+
+	return response
+}
+
+// policyFor 체크 ID에 적용할 설정 반환 (체크별 설정이 기본값을 덮어씀)
+func (h *Handler) policyFor(id string) CheckPolicy {
+	policy := CheckPolicy{
+		Mode:              h.config.DefaultMode,
+		NamespaceSelector: h.config.NamespaceSelector,
+	}
+	if override, ok := h.config.Checks[id]; ok {
+		if override.Mode != "" {
+			policy.Mode = override.Mode
+		}
+		if override.NamespaceSelector != nil {
+			policy.NamespaceSelector = override.NamespaceSelector
+		}
+	}
+	return policy
+}
+
+func (h *Handler) usesNamespaceSelector() bool {
+	if h.config.NamespaceSelector != nil {
+		return true
+	}
+	for _, policy := range h.config.Checks {
+		if policy.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) namespaceLabels(ctx context.Context, namespace string) (labels.Set, error) {
+	if namespace == "" {
+		return labels.Set{}, nil
+	}
+	if h.client == nil {
+		return nil, fmt.Errorf("네임스페이스 셀렉터를 사용하려면 Kubernetes 클라이언트가 필요합니다")
+	}
+	ns, err := h.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return labels.Set(ns.Labels), nil
+}
+
+// extractPodSpec 요청 객체에서 평가할 메타데이터와 PodSpec 추출
+func extractPodSpec(kind string, raw []byte) (metav1.ObjectMeta, corev1.PodSpec, bool, error) {
+	switch kind {
+	case "Pod":
+		var pod corev1.Pod
+		if err := json.Unmarshal(raw, &pod); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return pod.ObjectMeta, pod.Spec, true, nil
+	case "Deployment":
+		var obj appsv1.Deployment
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return templateMeta(obj.ObjectMeta, obj.Spec.Template), obj.Spec.Template.Spec, true, nil
+	case "StatefulSet":
+		var obj appsv1.StatefulSet
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return templateMeta(obj.ObjectMeta, obj.Spec.Template), obj.Spec.Template.Spec, true, nil
+	case "DaemonSet":
+		var obj appsv1.DaemonSet
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return templateMeta(obj.ObjectMeta, obj.Spec.Template), obj.Spec.Template.Spec, true, nil
+	case "ReplicaSet":
+		var obj appsv1.ReplicaSet
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return templateMeta(obj.ObjectMeta, obj.Spec.Template), obj.Spec.Template.Spec, true, nil
+	case "Job":
+		var obj batchv1.Job
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		return templateMeta(obj.ObjectMeta, obj.Spec.Template), obj.Spec.Template.Spec, true, nil
+	case "CronJob":
+		var obj batchv1.CronJob
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return metav1.ObjectMeta{}, corev1.PodSpec{}, false, err
+		}
+		template := obj.Spec.JobTemplate.Spec.Template
+		return templateMeta(obj.ObjectMeta, template), template.Spec, true, nil
+	}
+	return metav1.ObjectMeta{}, corev1.PodSpec{}, false, nil
+}
+
+// templateMeta 워크로드 이름과 Pod 템플릿 라벨을 합쳐 Pod와 동일한 기준으로 평가할 수 있게 함
+func templateMeta(owner metav1.ObjectMeta, template corev1.PodTemplateSpec) metav1.ObjectMeta {
+	meta := template.ObjectMeta
+	meta.Name = owner.Name
+	meta.Namespace = owner.Namespace
+	return meta
+}
@@ -0,0 +1,166 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"eks-checklist/cmd/testutils"
+	"eks-checklist/cmd/webhook"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+// buildPodSpec 모든 체크를 통과하거나 모두 위반하는 PodSpec 생성
+func buildPodSpec(compliant bool, image string) corev1.PodSpec {
+	container := corev1.Container{Name: "app", Image: image}
+	spec := corev1.PodSpec{}
+
+	if compliant {
+		container.SecurityContext = &corev1.SecurityContext{
+			RunAsUser:              int64Ptr(1000),
+			ReadOnlyRootFilesystem: boolPtr(true),
+		}
+		container.StartupProbe = &corev1.Probe{}
+		container.LivenessProbe = &corev1.Probe{}
+		container.ReadinessProbe = &corev1.Probe{}
+		container.Resources = corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m")},
+		}
+		spec.Affinity = &corev1.Affinity{}
+	}
+
+	spec.Containers = []corev1.Container{container}
+	return spec
+}
+
+func buildObject(kind string, namespace string, spec corev1.PodSpec) runtime.Object {
+	meta := metav1.ObjectMeta{Name: "workload", Namespace: namespace}
+	template := corev1.PodTemplateSpec{Spec: spec}
+
+	switch kind {
+	case "Pod":
+		return &corev1.Pod{ObjectMeta: meta, Spec: spec}
+	case "Deployment":
+		return &appsv1.Deployment{ObjectMeta: meta, Spec: appsv1.DeploymentSpec{Template: template}}
+	case "StatefulSet":
+		return &appsv1.StatefulSet{ObjectMeta: meta, Spec: appsv1.StatefulSetSpec{Template: template}}
+	case "DaemonSet":
+		return &appsv1.DaemonSet{ObjectMeta: meta, Spec: appsv1.DaemonSetSpec{Template: template}}
+	case "CronJob":
+		return &batchv1.CronJob{ObjectMeta: meta, Spec: batchv1.CronJobSpec{
+			JobTemplate: batchv1.JobTemplateSpec{Spec: batchv1.JobSpec{Template: template}},
+		}}
+	}
+	return &corev1.ConfigMap{ObjectMeta: meta}
+}
+
+func TestHandlerReview(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "webhook_review.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+		kind := tc["kind"].(string)
+		namespace := tc["namespace"].(string)
+		compliant := tc["compliant"].(bool)
+		expectAllowed := tc["expect_allowed"].(bool)
+
+		image := "nginx:1.27"
+		if v, ok := tc["image"].(string); ok {
+			image = v
+		}
+
+		var expectWarnings []string
+		for _, id := range tc["expect_warnings"].([]interface{}) {
+			expectWarnings = append(expectWarnings, id.(string))
+		}
+
+		t.Run(testName, func(t *testing.T) {
+			config := webhook.Config{DefaultMode: webhook.ModeWarn, Checks: map[string]webhook.CheckPolicy{}}
+			if mode, ok := tc["mode"].(string); ok {
+				config.DefaultMode = webhook.Mode(mode)
+			}
+			if modes, ok := tc["check_modes"].(map[string]interface{}); ok {
+				for id, mode := range modes {
+					config.Checks[id] = webhook.CheckPolicy{Mode: webhook.Mode(mode.(string))}
+				}
+			}
+			if expr, ok := tc["namespace_selector"].(string); ok {
+				selector, err := labels.Parse(expr)
+				if err != nil {
+					t.Fatalf("셀렉터 파싱 실패: %v", err)
+				}
+				config.NamespaceSelector = selector
+			}
+
+			nsLabels := map[string]string{}
+			if raw, ok := tc["namespace_labels"].(map[string]interface{}); ok {
+				for k, v := range raw {
+					nsLabels[k] = v.(string)
+				}
+			}
+			client := fake.NewSimpleClientset(&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{Name: namespace, Labels: nsLabels},
+			})
+
+			raw, err := json.Marshal(buildObject(kind, namespace, buildPodSpec(compliant, image)))
+			if err != nil {
+				t.Fatalf("객체 직렬화 실패: %v", err)
+			}
+
+			handler := webhook.NewHandler(config, client)
+			response := handler.Review(context.TODO(), &admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Kind: kind},
+				Namespace: namespace,
+				Operation: admissionv1.Create,
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+
+			if response.Allowed != expectAllowed {
+				t.Errorf("Test '%s' failed: expected allowed %v, got %v", testName, expectAllowed, response.Allowed)
+			}
+
+			var gotWarnings []string
+			for _, warning := range response.Warnings {
+				id := strings.TrimPrefix(strings.SplitN(warning, "]", 2)[0], "[")
+				gotWarnings = append(gotWarnings, id)
+			}
+			if strings.Join(gotWarnings, ",") != strings.Join(expectWarnings, ",") {
+				t.Errorf("Test '%s' failed: expected warnings %v, got %v", testName, expectWarnings, gotWarnings)
+			}
+		})
+	}
+}
+
+func TestHandlerServeHTTPBodyLimit(t *testing.T) {
+	handler := webhook.NewHandler(webhook.Config{}, nil)
+
+	body := `{"request":{"uid":"1","kind":{"kind":"Pod"},"operation":"CREATE","object":{"metadata":{"annotations":{"a":"` +
+		strings.Repeat("x", webhook.MaxRequestBytes) + `"}}}}}`
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/validate", strings.NewReader(body)))
+
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d for oversized body, got %d", http.StatusRequestEntityTooLarge, recorder.Code)
+	}
+}
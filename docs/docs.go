@@ -0,0 +1,10 @@
+// Package docs 바이너리에 포함하는 문서 (mkdocs 사이트 원본)
+package docs
+
+import "embed"
+
+// Runbooks docs/runbook 하위 런북 마크다운
+// 인터넷에 접근할 수 없는 환경에서도 explain, TUI, 보고서에서 런북을 볼 수 있도록 바이너리에 포함
+//
+//go:embed runbook/*.md runbook/*/*.md
+var Runbooks embed.FS
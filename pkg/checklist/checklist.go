@@ -0,0 +1,251 @@
+// Package checklist EKS 체크리스트를 다른 Go 프로그램에서 실행하기 위한 API
+//
+// 클라이언트는 호출하는 쪽에서 생성해 전달하며, 실행 상태는 Run 호출마다 따로 유지되므로
+// 같은 프로세스에서 여러 클러스터를 동시에 점검할 수 있습니다.
+// 오류는 모두 반환값으로 전달하며 os.Exit나 panic으로 프로세스를 종료하지 않습니다.
+//
+//	report, err := checklist.Run(ctx, checklist.Options{
+//		Client:         clientset,
+//		DynamicClient:  dynamicClient,
+//		MetadataClient: metadataClient,
+//		AWSConfig:      &awsCfg,
+//		ClusterName:    "my-cluster",
+//	})
+package checklist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"eks-checklist/cmd/checks"
+	"eks-checklist/cmd/common"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+)
+
+// 체크 결과 상태
+type Status string
+
+const (
+	StatusPass          Status = "pass"
+	StatusFail          Status = "fail"
+	StatusManual        Status = "manual"
+	StatusNotApplicable Status = "n/a"
+	// StatusTimeout 시간 초과 또는 취소로 완료하지 못한 체크
+	StatusTimeout Status = "timeout"
+)
+
+// Options 체크리스트 실행 옵션
+type Options struct {
+	// Client Kubernetes 클라이언트 (필수)
+	Client kubernetes.Interface
+	// DynamicClient Karpenter 등 CRD 조회용 클라이언트 (필수)
+	DynamicClient dynamic.Interface
+	// MetadataClient 메타데이터만 조회하는 클라이언트 (필수, SEC-017이 Secret 값을 읽지 않고 소유자만 확인)
+	MetadataClient metadata.Interface
+	// AWSConfig AWS SDK 설정 (nil이면 AWS API가 필요한 체크는 적용 불가(N/A)로 표시)
+	AWSConfig *aws.Config
+	// ClusterName EKS 클러스터 이름 (필수)
+	ClusterName string
+	// EvidenceDir 수동 점검용 증적 파일을 저장할 디렉터리 (체크별로 <EvidenceDir>/<체크 ID>에 저장)
+	// 비어 있으면 작업 디렉터리의 output/<ClusterName>/evidence
+	EvidenceDir string
+	// ClusterAdminAllowlist SEC-002에서 cluster 범위 AmazonEKSClusterAdminPolicy 연결을 허용할 principal ARN 패턴 (*는 임의의 문자열)
+	ClusterAdminAllowlist []string
+	// RestrictSecurityGroupEgress SEC-019에서 인터넷(0.0.0.0/0, ::/0)으로 열린 보안 그룹 아웃바운드 규칙을 실패로 판정
+	RestrictSecurityGroupEgress bool
+	// Cluster DescribeCluster 결과 (nil이고 AWSConfig가 있으면 Run에서 조회)
+	Cluster *types.Cluster
+	// Categories 실행할 카테고리 (비어 있으면 전체)
+	Categories []string
+	// CheckIDs 실행할 체크 ID (비어 있으면 전체, Categories와 함께 지정하면 둘 다 만족하는 체크만 실행)
+	CheckIDs []string
+	// CheckTimeout 체크별 최대 실행 시간 (0이면 제한 없음, 전체 실행 시간은 ctx로 제한)
+	CheckTimeout time.Duration
+	// OnResult 각 체크가 끝날 때마다 호출 (진행 상황 출력 등, nil 허용)
+	OnResult func(Result)
+}
+
+// Result 체크 결과
+type Result struct {
+	ID       string
+	Category string
+	// CheckName "[ID] 체크 이름" 형식의 이름
+	CheckName string
+	Status    Status
+	// Message 실패/수동 점검/적용 불가 사유
+	Message   string
+	Resources []string
+	Runbook   string
+}
+
+// Summary 상태별 체크 수
+type Summary struct {
+	Pass          int
+	Fail          int
+	Manual        int
+	NotApplicable int
+	Timeout       int
+}
+
+// Report 체크리스트 실행 결과
+type Report struct {
+	ClusterName string
+	StartedAt   time.Time
+	FinishedAt  time.Time
+	// Results 실행한 체크 결과 (카테고리 및 등록 순서)
+	Results []Result
+	// NotRun 전체 실행 시간 초과 또는 취소로 실행하지 못한 체크 ID
+	NotRun  []string
+	Summary Summary
+}
+
+// Incomplete 실행하지 못한 체크가 있는지 여부 (부분 보고서)
+func (r *Report) Incomplete() bool {
+	return len(r.NotRun) > 0
+}
+
+// Categories 카테고리 목록 (출력 순서)
+func Categories() []string {
+	return append([]string{}, checks.Categories...)
+}
+
+// Run 선택한 체크를 순서대로 실행하고 보고서를 반환
+// 체크별 실행 시간이 CheckTimeout을 넘으면 해당 체크를 시간 초과로 기록하고 다음 체크를 실행하며,
+// ctx가 만료되거나 취소되면 실행 중인 체크를 시간 초과로 기록하고 남은 체크는 NotRun에 담아
+// 부분 보고서와 ctx.Err()를 함께 반환
+func Run(ctx context.Context, opts Options) (*Report, error) {
+	if opts.Client == nil {
+		return nil, fmt.Errorf("Kubernetes 클라이언트(Client)가 필요합니다")
+	}
+	if opts.DynamicClient == nil {
+		return nil, fmt.Errorf("Dynamic 클라이언트(DynamicClient)가 필요합니다")
+	}
+	if opts.MetadataClient == nil {
+		return nil, fmt.Errorf("Metadata 클라이언트(MetadataClient)가 필요합니다")
+	}
+	if opts.ClusterName == "" {
+		return nil, fmt.Errorf("클러스터 이름(ClusterName)이 필요합니다")
+	}
+
+	selected, err := checks.Select(opts.Categories, opts.CheckIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &checks.Env{
+		Client:                      opts.Client,
+		DynamicClient:               opts.DynamicClient,
+		MetadataClient:              opts.MetadataClient,
+		Cluster:                     opts.Cluster,
+		ClusterName:                 opts.ClusterName,
+		EvidenceDir:                 opts.EvidenceDir,
+		ClusterAdminAllowlist:       opts.ClusterAdminAllowlist,
+		RestrictSecurityGroupEgress: opts.RestrictSecurityGroupEgress,
+	}
+	if opts.AWSConfig != nil {
+		env.AWSConfig = opts.AWSConfig.Copy()
+		if env.Cluster == nil {
+			output, err := eks.NewFromConfig(env.AWSConfig).DescribeCluster(ctx, &eks.DescribeClusterInput{
+				Name: aws.String(opts.ClusterName),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("EKS 클러스터 '%s' 조회 실패: %w", opts.ClusterName, err)
+			}
+			env.Cluster = output.Cluster
+		}
+	}
+
+	report := &Report{ClusterName: opts.ClusterName, StartedAt: time.Now()}
+	for i, check := range selected {
+		if err := ctx.Err(); err != nil {
+			for _, notRun := range selected[i:] {
+				report.NotRun = append(report.NotRun, notRun.ID)
+			}
+			report.FinishedAt = time.Now()
+			return report, err
+		}
+
+		var r common.CheckResult
+		if check.RequiresAWS && opts.AWSConfig == nil {
+			r = check.NotApplicableResult("AWS 설정(AWSConfig)이 없어 평가하지 않았습니다.")
+		} else {
+			r = execute(ctx, check, env, opts.CheckTimeout)
+		}
+
+		result := newResult(check, r)
+		report.add(result)
+		if opts.OnResult != nil {
+			opts.OnResult(result)
+		}
+	}
+
+	report.FinishedAt = time.Now()
+	return report, ctx.Err()
+}
+
+// execute 체크별 시간 제한을 적용해 실행
+func execute(ctx context.Context, check checks.Check, env *checks.Env, timeout time.Duration) common.CheckResult {
+	if timeout <= 0 {
+		return check.Execute(ctx, env)
+	}
+	checkCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return check.Execute(checkCtx, env)
+}
+
+func newResult(check checks.Check, r common.CheckResult) Result {
+	result := Result{
+		ID:        check.ID,
+		Category:  check.Category,
+		CheckName: r.CheckName,
+		Status:    Status(common.ResultStatus(r)),
+		Resources: r.Resources,
+		Runbook:   r.Runbook,
+	}
+	if result.CheckName == "" {
+		result.CheckName = check.Title()
+	}
+	// 통과한 체크도 FailureMsg가 채워져 있는 경우가 있어 통과 이외의 상태에서만 메시지 포함
+	if result.Status != StatusPass {
+		result.Message = r.FailureMsg
+	}
+	return result
+}
+
+func (r *Report) add(result Result) {
+	r.Results = append(r.Results, result)
+	switch result.Status {
+	case StatusPass:
+		r.Summary.Pass++
+	case StatusFail:
+		r.Summary.Fail++
+	case StatusManual:
+		r.Summary.Manual++
+	case StatusNotApplicable:
+		r.Summary.NotApplicable++
+	case StatusTimeout:
+		r.Summary.Timeout++
+	}
+}
+
+// CheckResult 결과를 CLI 출력(common 패키지)에서 사용하는 형식으로 변환
+func (r Result) CheckResult() common.CheckResult {
+	return common.CheckResult{
+		CheckName:     r.CheckName,
+		Passed:        r.Status == StatusPass,
+		Manual:        r.Status == StatusManual,
+		NotApplicable: r.Status == StatusNotApplicable,
+		TimedOut:      r.Status == StatusTimeout,
+		FailureMsg:    r.Message,
+		Resources:     r.Resources,
+		Runbook:       r.Runbook,
+		Category:      r.Category,
+	}
+}
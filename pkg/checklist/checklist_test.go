@@ -0,0 +1,168 @@
+package checklist_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"eks-checklist/cmd/manifests"
+	"eks-checklist/cmd/testutils"
+	"eks-checklist/pkg/checklist"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+func options(t *testing.T, manifest string) checklist.Options {
+	set, err := manifests.Load([]string{"-"}, strings.NewReader(manifest), "default")
+	if err != nil {
+		t.Fatalf("매니페스트 로드 실패: %v", err)
+	}
+	return checklist.Options{
+		Client:         set.Clientset(),
+		DynamicClient:  set.DynamicClient(),
+		MetadataClient: set.MetadataClient(),
+		ClusterName:    "test-cluster",
+		EvidenceDir:    t.TempDir(),
+	}
+}
+
+func TestRun(t *testing.T) {
+	testCases := testutils.LoadTestCases(t, "checklist_run.yaml")
+
+	for _, tc := range testCases {
+		testName := tc["name"].(string)
+
+		t.Run(testName, func(t *testing.T) {
+			opts := options(t, tc["manifest"].(string))
+			opts.Categories = testutils.ToStrings(tc["categories"])
+			opts.CheckIDs = testutils.ToStrings(tc["check_ids"])
+
+			var streamed int
+			opts.OnResult = func(checklist.Result) { streamed++ }
+
+			report, err := checklist.Run(context.Background(), opts)
+			if expectErr := tc["expect_error"].(string); expectErr != "" {
+				if err == nil || !strings.Contains(err.Error(), expectErr) {
+					t.Fatalf("Test '%s' failed: expected error %q, got %v", testName, expectErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Test '%s' failed: unexpected error: %v", testName, err)
+			}
+
+			if len(report.Results) != tc["expect_count"].(int) || streamed != len(report.Results) {
+				t.Errorf("Test '%s' failed: expected %d results, got %d (streamed %d)", testName, tc["expect_count"].(int), len(report.Results), streamed)
+			}
+
+			statuses := make(map[string]checklist.Status)
+			var order []string
+			for _, r := range report.Results {
+				statuses[r.ID] = r.Status
+				order = append(order, r.ID)
+			}
+			for id, expect := range tc["expect_status"].(map[string]interface{}) {
+				if string(statuses[id]) != expect.(string) {
+					t.Errorf("Test '%s' failed: %s expected %s, got %s", testName, id, expect, statuses[id])
+				}
+			}
+			if expectOrder := testutils.ToStrings(tc["expect_order"]); expectOrder != nil && strings.Join(order, ",") != strings.Join(expectOrder, ",") {
+				t.Errorf("Test '%s' failed: expected order %v, got %v", testName, expectOrder, order)
+			}
+
+			summary := report.Summary
+			if summary.Pass+summary.Fail+summary.Manual+summary.NotApplicable+summary.Timeout != len(report.Results) {
+				t.Errorf("Test '%s' failed: summary %+v does not match %d results", testName, summary, len(report.Results))
+			}
+		})
+	}
+}
+
+func TestRunValidatesOptions(t *testing.T) {
+	if _, err := checklist.Run(context.Background(), checklist.Options{ClusterName: "test-cluster"}); err == nil {
+		t.Errorf("expected error when clients are missing")
+	}
+
+	opts := options(t, "")
+	opts.MetadataClient = nil
+	if _, err := checklist.Run(context.Background(), opts); err == nil {
+		t.Errorf("expected error when metadata client is missing")
+	}
+
+	opts = options(t, "")
+	opts.ClusterName = ""
+	if _, err := checklist.Run(context.Background(), opts); err == nil {
+		t.Errorf("expected error when cluster name is missing")
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report, err := checklist.Run(ctx, options(t, ""))
+	if err != context.Canceled {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if report == nil || len(report.Results) != 0 {
+		t.Errorf("expected empty partial report, got %+v", report)
+	}
+}
+
+func TestRunCheckTimeout(t *testing.T) {
+	opts := options(t, "")
+	opts.CheckIDs = []string{"GEN-003", "SEC-005"}
+	opts.CheckTimeout = 20 * time.Millisecond
+
+	// Pod 조회가 체크별 시간 제한보다 오래 걸리도록 지연
+	opts.Client.(*fake.Clientset).PrependReactor("list", "pods", func(k8stesting.Action) (bool, runtime.Object, error) {
+		time.Sleep(200 * time.Millisecond)
+		return false, nil, nil
+	})
+
+	report, err := checklist.Run(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(report.Results) != 2 || report.Summary.Timeout != 2 {
+		t.Fatalf("expected 2 timed out results, got %+v", report.Summary)
+	}
+	for _, r := range report.Results {
+		if r.Status != checklist.StatusTimeout || r.Message == "" {
+			t.Errorf("%s: expected timeout with message, got %s %q", r.ID, r.Status, r.Message)
+		}
+		if !r.CheckResult().TimedOut {
+			t.Errorf("%s: expected TimedOut in converted result", r.ID)
+		}
+	}
+}
+
+func TestRunConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	reports := make([]*checklist.Report, 4)
+	for i := range reports {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			opts := options(t, "")
+			opts.CheckIDs = []string{"GEN-003", "SEC-005", "REL-001"}
+			report, err := checklist.Run(context.Background(), opts)
+			if err != nil {
+				t.Errorf("run %d failed: %v", i, err)
+				return
+			}
+			reports[i] = report
+		}(i)
+	}
+	wg.Wait()
+
+	for i, report := range reports {
+		if report != nil && len(report.Results) != 3 {
+			t.Errorf("run %d: expected 3 results, got %d", i, len(report.Results))
+		}
+	}
+}
@@ -0,0 +1,9 @@
+// Package templates 바이너리에 포함하는 보고서 템플릿
+package templates
+
+import _ "embed"
+
+// Report 기본 HTML 보고서 템플릿 (--template을 지정하지 않으면 사용)
+//
+//go:embed report.html
+var Report string